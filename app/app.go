@@ -4,9 +4,17 @@ import (
 	"fmt"
 	v010 "github.com/bitsongofficial/go-bitsong/app/upgrades/v010"
 	v011 "github.com/bitsongofficial/go-bitsong/app/upgrades/v011"
+	v012 "github.com/bitsongofficial/go-bitsong/app/upgrades/v012"
+	"github.com/bitsongofficial/go-bitsong/wasmbinding"
 	"github.com/bitsongofficial/go-bitsong/x/fantoken"
 	fantokenkeeper "github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
 	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	"github.com/bitsongofficial/go-bitsong/x/fees"
+	feeskeeper "github.com/bitsongofficial/go-bitsong/x/fees/keeper"
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken"
+	ibcfantokenkeeper "github.com/bitsongofficial/go-bitsong/x/ibcfantoken/keeper"
+	ibcfantokentypes "github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop"
 	merkledropkeeper "github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
 	merkledroptypes "github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
@@ -35,6 +43,9 @@ import (
 	routerkeeper "github.com/strangelove-ventures/packet-forward-middleware/v2/router/keeper"
 	routertypes "github.com/strangelove-ventures/packet-forward-middleware/v2/router/types"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/spf13/cast"
@@ -42,6 +53,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	fantokenclient "github.com/bitsongofficial/go-bitsong/x/fantoken/client"
@@ -139,14 +151,20 @@ var (
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
-			paramsclient.ProposalHandler,
-			distrclient.ProposalHandler,
-			upgradeclient.ProposalHandler,
-			upgradeclient.CancelProposalHandler,
-			ibcclientclient.UpdateClientProposalHandler,
-			ibcclientclient.UpgradeProposalHandler,
-			fantokenclient.ProposalHandler,
-			merkledropclient.ProposalHandler,
+			append(
+				wasmclient.ProposalHandlers,
+				paramsclient.ProposalHandler,
+				distrclient.ProposalHandler,
+				upgradeclient.ProposalHandler,
+				upgradeclient.CancelProposalHandler,
+				ibcclientclient.UpdateClientProposalHandler,
+				ibcclientclient.UpgradeProposalHandler,
+				fantokenclient.ProposalHandler,
+				fantokenclient.VerifiedSymbolProposalHandler,
+				fantokenclient.ParamsProposalHandler,
+				merkledropclient.ProposalHandler,
+				merkledropclient.ParamsProposalHandler,
+			)...,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -161,6 +179,9 @@ var (
 		router.AppModuleBasic{},
 		fantoken.AppModuleBasic{},
 		merkledrop.AppModuleBasic{},
+		fees.AppModuleBasic{},
+		wasm.AppModuleBasic{},
+		ibcfantoken.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -174,6 +195,9 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		fantokentypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 		merkledroptypes.ModuleName:     nil,
+		feestypes.ModuleName:           {authtypes.Burner},
+		wasm.ModuleName:                {authtypes.Burner},
+		ibcfantokentypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -200,6 +224,7 @@ type BitsongApp struct {
 	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -228,11 +253,17 @@ type BitsongApp struct {
 	RouterKeeper     routerkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-
-	FanTokenKeeper   fantokenkeeper.Keeper
-	MerkledropKeeper merkledropkeeper.Keeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedWasmKeeper        capabilitykeeper.ScopedKeeper
+	ScopedIBCFanTokenKeeper capabilitykeeper.ScopedKeeper
+
+	FanTokenKeeper     fantokenkeeper.Keeper
+	FanTokenBankKeeper fantokenkeeper.BankKeeper
+	MerkledropKeeper   merkledropkeeper.Keeper
+	FeesKeeper         feeskeeper.Keeper
+	WasmKeeper         wasm.Keeper
+	IBCFanTokenKeeper  ibcfantokenkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -264,6 +295,7 @@ func NewBitsongApp(
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey, routertypes.StoreKey, fantokentypes.StoreKey, merkledroptypes.StoreKey,
+		wasm.StoreKey, ibcfantokentypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -273,6 +305,7 @@ func NewBitsongApp(
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -290,6 +323,8 @@ func NewBitsongApp(
 	// grant capabilities for the ibc and ibc-transfer modules
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
+	scopedIBCFanTokenKeeper := app.CapabilityKeeper.ScopeToModule(ibcfantokentypes.ModuleName)
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -339,6 +374,18 @@ func NewBitsongApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// the gov module account is the authority updating the params of the
+	// bitsong modules
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Create Fees Keeper
+	app.FeesKeeper = feeskeeper.NewKeeper(
+		app.GetSubspace(feestypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+	)
+
 	// Create Fantoken Keeper
 	app.FanTokenKeeper = fantokenkeeper.NewKeeper(
 		appCodec,
@@ -346,18 +393,81 @@ func NewBitsongApp(
 		app.GetSubspace(fantokentypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
-		app.DistrKeeper,
+		app.FeesKeeper,
 		app.ModuleAccountAddrs(),
+		authority,
 	)
 
+	// the bank msg server and the modules moving the coins on behalf of the
+	// accounts send them through the fantoken bank keeper, which rejects the
+	// transfers of the paused fantokens and collects the royalties
+	app.FanTokenBankKeeper = fantokenkeeper.NewBankKeeper(app.BankKeeper, &app.FanTokenKeeper)
+
 	// Create Merkledrop Keeper
 	app.MerkledropKeeper = merkledropkeeper.NewKeeper(
 		appCodec,
 		keys[merkledroptypes.StoreKey],
 		app.AccountKeeper,
+		app.FanTokenBankKeeper,
 		app.BankKeeper,
-		app.DistrKeeper,
+		app.FeesKeeper,
 		app.GetSubspace(merkledroptypes.ModuleName),
+		authority,
+	)
+
+	// Create Transfer Keepers, the fantoken middleware carries the metadata of
+	// the fantokens in the memo of the ICS-20 packets
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		fantoken.NewICS4Wrapper(app.IBCKeeper.ChannelKeeper, app.FanTokenKeeper), app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
+	)
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+	transferStack := fantoken.NewIBCMiddleware(transferIBCModule, app.FanTokenKeeper)
+
+	app.RouterKeeper = routerkeeper.NewKeeper(
+		appCodec, keys[routertypes.StoreKey], app.GetSubspace(routertypes.ModuleName), app.TransferKeeper, app.DistrKeeper,
+	)
+
+	routerModule := router.NewAppModule(app.RouterKeeper, transferIBCModule)
+
+	// Create IBC FanToken Keeper, the registered controllers of the
+	// counterparty chains mint and burn the fantokens over its own port
+	app.IBCFanTokenKeeper = ibcfantokenkeeper.NewKeeper(
+		appCodec, keys[ibcfantokentypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, scopedIBCFanTokenKeeper,
+		app.AccountKeeper, app.BankKeeper, app.FanTokenKeeper,
+	)
+	ibcFanTokenModule := ibcfantoken.NewIBCModule(app.IBCFanTokenKeeper)
+
+	// Create Wasm Keeper, the contracts can issue and mint fantokens through
+	// the custom bindings
+	wasmDir := filepath.Join(homePath, "wasm")
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm config: %s", err))
+	}
+
+	supportedFeatures := "iterator,staking,stargate,bitsong"
+	app.WasmKeeper = wasm.NewKeeper(
+		appCodec,
+		keys[wasm.StoreKey],
+		app.GetSubspace(wasm.ModuleName),
+		app.AccountKeeper,
+		app.FanTokenBankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedWasmKeeper,
+		app.TransferKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		wasmDir,
+		wasmConfig,
+		supportedFeatures,
+		wasmbinding.RegisterCustomPlugins(&app.FanTokenKeeper)...,
 	)
 
 	// register the proposal types
@@ -369,7 +479,8 @@ func NewBitsongApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(fantokentypes.RouterKey, fantoken.NewProposalHandler(app.FanTokenKeeper)).
-		AddRoute(merkledroptypes.RouterKey, merkledrop.NewProposalHandler(app.MerkledropKeeper))
+		AddRoute(merkledroptypes.RouterKey, merkledrop.NewProposalHandler(app.MerkledropKeeper)).
+		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, wasm.EnableAllProposals))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -381,23 +492,12 @@ func NewBitsongApp(
 		govRouter,
 	)
 
-	// Create Transfer Keepers
-	app.TransferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
-	)
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
-
-	app.RouterKeeper = routerkeeper.NewKeeper(
-		appCodec, keys[routertypes.StoreKey], app.GetSubspace(routertypes.ModuleName), app.TransferKeeper, app.DistrKeeper,
-	)
-
-	routerModule := router.NewAppModule(app.RouterKeeper, transferIBCModule)
-	// create static IBC router, add transfer route, then set and seal it
+	// create static IBC router, add transfer, wasm and ibcfantoken routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper)).
+		AddRoute(ibcfantokentypes.ModuleName, ibcFanTokenModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
@@ -419,7 +519,7 @@ func NewBitsongApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newBankModule(appCodec, app.BankKeeper, app.FanTokenBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -430,13 +530,16 @@ func NewBitsongApp(
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		fantoken.NewAppModule(appCodec, app.FanTokenKeeper, app.BankKeeper),
-		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		fantoken.NewAppModule(appCodec, app.FanTokenKeeper, app.AccountKeeper, app.BankKeeper),
+		newAuthzModule(appCodec, app.AuthzKeeper, &app.FanTokenKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		routerModule,
-		merkledrop.NewAppModule(appCodec, app.MerkledropKeeper),
+		merkledrop.NewAppModule(appCodec, app.MerkledropKeeper, app.AccountKeeper, app.BankKeeper),
+		fees.NewAppModule(app.FeesKeeper),
+		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		ibcfantoken.NewAppModule(app.IBCFanTokenKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -449,6 +552,7 @@ func NewBitsongApp(
 		authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, paramstypes.ModuleName, vestingtypes.ModuleName, fantokentypes.ModuleName, merkledroptypes.ModuleName,
+		feestypes.ModuleName, wasm.ModuleName, ibcfantokentypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -456,6 +560,7 @@ func NewBitsongApp(
 		routertypes.ModuleName, feegrant.ModuleName, authz.ModuleName, capabilitytypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName, minttypes.ModuleName, genutiltypes.ModuleName,
 		evidencetypes.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, fantokentypes.ModuleName, merkledroptypes.ModuleName,
+		feestypes.ModuleName, wasm.ModuleName, ibcfantokentypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -467,25 +572,29 @@ func NewBitsongApp(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
+		feestypes.ModuleName,
 		fantokentypes.ModuleName,
 		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		evidencetypes.ModuleName,
 		feegrant.ModuleName,
 		authz.ModuleName,
-		authtypes.ModuleName,
 		genutiltypes.ModuleName,
 		routertypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		merkledroptypes.ModuleName,
+		// wasm after ibc transfer, so that the contracts can use the channels
+		wasm.ModuleName,
+		ibcfantokentypes.ModuleName,
+		// crisis needs to be last so that the invariants are asserted on the whole genesis state
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -501,7 +610,9 @@ func NewBitsongApp(
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
-		// fantoken.NewAppModule(appCodec, app.FanTokenKeeper, app.AccountKeeper, app.BankKeeper),
+		fantoken.NewAppModule(appCodec, app.FanTokenKeeper, app.AccountKeeper, app.BankKeeper),
+		merkledrop.NewAppModule(appCodec, app.MerkledropKeeper, app.AccountKeeper, app.BankKeeper),
+		fees.NewAppModule(app.FeesKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
@@ -532,7 +643,9 @@ func NewBitsongApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCkeeper: app.IBCKeeper,
+			IBCkeeper:         app.IBCKeeper,
+			WasmConfig:        &wasmConfig,
+			TXCounterStoreKey: keys[wasm.StoreKey],
 		},
 	)
 	if err != nil {
@@ -549,14 +662,32 @@ func NewBitsongApp(
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
+	// the wasm snapshotter must be registered before loading the version
+	if manager := app.SnapshotManager(); manager != nil {
+		err := manager.RegisterExtensions(
+			wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.WasmKeeper),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// initialize the pinned codes in wasmvm, as they are not persisted there
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedWasmKeeper = scopedWasmKeeper
+	app.ScopedIBCFanTokenKeeper = scopedIBCFanTokenKeeper
 
 	return app
 }
@@ -651,6 +782,34 @@ func (app *BitsongApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// GetBaseApp returns the BaseApp of the app, the following getters implement
+// the ibc-go TestingApp interface.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *BitsongApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper
+func (app *BitsongApp) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper
+func (app *BitsongApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped keeper of the IBC module
+func (app *BitsongApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of the app
+func (app *BitsongApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *BitsongApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -713,6 +872,16 @@ func (app *BitsongApp) setupUpgradeStoreLoaders() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+
+	// v12 Upgrade
+	if upgradeInfo.Name == v012.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := store.StoreUpgrades{
+			Added: []string{wasm.ModuleName, ibcfantokentypes.ModuleName},
+		}
+
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 func (app *BitsongApp) setupUpgradeHandlers() {
@@ -725,6 +894,11 @@ func (app *BitsongApp) setupUpgradeHandlers() {
 		v011.UpgradeName,
 		v011.CreateUpgradeHandler(app.mm, app.configurator, &app.FanTokenKeeper, &app.MerkledropKeeper),
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v012.UpgradeName,
+		v012.CreateUpgradeHandler(app.mm, app.configurator, &app.FanTokenKeeper),
+	)
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
@@ -755,6 +929,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(routertypes.ModuleName).WithKeyTable(routertypes.ParamKeyTable())
 	paramsKeeper.Subspace(fantokentypes.ModuleName)
 	paramsKeeper.Subspace(merkledroptypes.ModuleName)
+	paramsKeeper.Subspace(feestypes.ModuleName)
+	paramsKeeper.Subspace(wasm.ModuleName)
 
 	return paramsKeeper
 }
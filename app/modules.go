@@ -0,0 +1,68 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	fantokenkeeper "github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+)
+
+// bankModule is the x/bank module whose msg server sends the coins through the
+// fantoken bank keeper
+type bankModule struct {
+	bank.AppModule
+
+	keeper    bankkeeper.Keeper
+	msgKeeper fantokenkeeper.BankKeeper
+}
+
+func newBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, msgKeeper fantokenkeeper.BankKeeper, accountKeeper banktypes.AccountKeeper) bankModule {
+	return bankModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+		msgKeeper: msgKeeper,
+	}
+}
+
+// RegisterServices registers the bank services as the x/bank module does,
+// except for the msg server using the fantoken bank keeper
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.msgKeeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.(bankkeeper.BaseKeeper))
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
+// authzModule is the x/authz module whose msg server checks the holding of the
+// grantees of the fantoken holder authorizations
+type authzModule struct {
+	authzmodule.AppModule
+
+	keeper         authzkeeper.Keeper
+	fantokenKeeper *fantokenkeeper.Keeper
+}
+
+func newAuthzModule(cdc codec.Codec, keeper authzkeeper.Keeper, fantokenKeeper *fantokenkeeper.Keeper, accountKeeper authz.AccountKeeper, bankKeeper authz.BankKeeper, registry codectypes.InterfaceRegistry) authzModule {
+	return authzModule{
+		AppModule:      authzmodule.NewAppModule(cdc, keeper, accountKeeper, bankKeeper, registry),
+		keeper:         keeper,
+		fantokenKeeper: fantokenKeeper,
+	}
+}
+
+// RegisterServices registers the authz services as the x/authz module does,
+// except for the msg server wrapped by the fantoken module
+func (am authzModule) RegisterServices(cfg module.Configurator) {
+	authz.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	authz.RegisterMsgServer(cfg.MsgServer(), fantokenkeeper.NewAuthzMsgServer(am.keeper, am.fantokenKeeper))
+}
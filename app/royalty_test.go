@@ -0,0 +1,105 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdksimapp "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcsimapp "github.com/cosmos/ibc-go/v3/testing/simapp"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	merkledroptypes "github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := NewBitsongApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 5,
+		MakeEncodingConfig(), sdksimapp.EmptyAppOptions{},
+	)
+	return app, NewDefaultGenesisState()
+}
+
+// deliverTx signs with the sender account and delivers in a new block a
+// transaction of the messages, which fails when expPass is false
+func deliverTx(t *testing.T, chain *ibctesting.TestChain, sender ibctesting.SenderAccount, expPass bool, msgs ...sdk.Msg) {
+	chain.Coordinator.UpdateTimeForChain(chain)
+
+	ibcsimapp.SignAndDeliver(
+		t, chain.TxConfig, chain.App.GetBaseApp(), chain.GetContext().BlockHeader(), msgs, chain.ChainID,
+		[]uint64{sender.SenderAccount.GetAccountNumber()}, []uint64{sender.SenderAccount.GetSequence()},
+		expPass, expPass, sender.SenderPrivKey,
+	)
+	chain.NextBlock()
+
+	// the sequence is incremented by the ante handler even when the messages fail
+	require.NoError(t, sender.SenderAccount.SetSequence(sender.SenderAccount.GetSequence()+1))
+	chain.Coordinator.IncrementTime()
+}
+
+func TestFanTokenRoyalties(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.App.(*BitsongApp)
+
+	authority := chain.SenderAccounts[0]
+	holder := chain.SenderAccounts[1]
+	grantee := chain.SenderAccounts[2]
+	holderAddr := holder.SenderAccount.GetAddress()
+	authorityAddr := authority.SenderAccount.GetAddress()
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	// a fantoken with a royalty of 5% paid to the authority
+	ctx := chain.GetContext()
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.IssueFee = nil
+	params.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", sdk.NewInt(10_000), authorityAddr, authorityAddr, 500, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, app.FanTokenKeeper.Mint(ctx, authorityAddr, holderAddr, sdk.NewInt64Coin(denom, 1000), ""))
+	coordinator.CommitBlock(chain)
+
+	requireBalances := func(holderAmt, authorityAmt, recipientAmt int64) {
+		ctx := chain.GetContext()
+		require.Equal(t, sdk.NewInt(holderAmt), app.BankKeeper.GetBalance(ctx, holderAddr, denom).Amount)
+		require.Equal(t, sdk.NewInt(authorityAmt), app.BankKeeper.GetBalance(ctx, authorityAddr, denom).Amount)
+		require.Equal(t, sdk.NewInt(recipientAmt), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	}
+
+	send := banktypes.NewMsgSend(holderAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+
+	// the sender pays the royalty on top of the sent amount
+	deliverTx(t, chain, holder, true, send)
+	requireBalances(895, 5, 100)
+
+	// an exec without grant does not charge the royalty to the granter
+	exec := authz.NewMsgExec(grantee.SenderAccount.GetAddress(), []sdk.Msg{send})
+	deliverTx(t, chain, grantee, false, &exec)
+	requireBalances(895, 5, 100)
+
+	// a failed transaction does not charge the royalty of its sends
+	failing := banktypes.NewMsgSend(holderAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("unknown", 1)))
+	deliverTx(t, chain, holder, false, send, failing)
+	requireBalances(895, 5, 100)
+
+	// the granter pays the royalty of the granted sends
+	grant, err := authz.NewMsgGrant(holderAddr, grantee.SenderAccount.GetAddress(), banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), chain.CurrentHeader.Time.AddDate(1, 0, 0))
+	require.NoError(t, err)
+	deliverTx(t, chain, holder, true, grant)
+	deliverTx(t, chain, grantee, true, &exec)
+	requireBalances(790, 10, 200)
+
+	// the sends to the module accounts are royalty free
+	ctx = chain.GetContext()
+	require.NoError(t, app.FanTokenBankKeeper.SendCoins(ctx, holderAddr, app.AccountKeeper.GetModuleAddress(merkledroptypes.ModuleName), sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireBalances(690, 10, 200)
+}
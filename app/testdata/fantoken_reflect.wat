@@ -0,0 +1,96 @@
+;; fantoken_reflect is the minimal contract used to test the custom bindings.
+;; The execute message is dispatched as the custom message of the contract,
+;; so the contract acts as the authority and minter of the fantokens it
+;; issues, while the query message is forwarded to the chain as a custom query.
+;;
+;; Build with: wat2wasm fantoken_reflect.wat -o fantoken_reflect.wasm
+(module
+  (import "env" "query_chain" (func $query_chain (param i32) (result i32)))
+
+  (memory (export "memory") 1)
+
+  ;; the heap starts after the static data and is never freed
+  (global $heap (mut i32) (i32.const 1024))
+
+  (data (i32.const 16) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 80) "{\"ok\":{\"messages\":[{\"id\":0,\"msg\":{\"custom\":")
+  (data (i32.const 128) "},\"gas_limit\":null,\"reply_on\":\"never\"}],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 216) "{\"custom\":")
+  (data (i32.const 232) "}")
+  (data (i32.const 240) "{\"error\":\"query failed\"}")
+
+  (func (export "interface_version_8"))
+
+  ;; allocate returns a region of the given capacity, made of the offset,
+  ;; capacity and length of the data
+  (func $allocate (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    (local.set $region (global.get $heap))
+    (global.set $heap
+      (i32.and
+        (i32.add (i32.add (local.get $region) (i32.const 12)) (i32.add (local.get $size) (i32.const 7)))
+        (i32.const -8)))
+    (block $done
+      (loop $grow
+        (br_if $done (i32.le_u (global.get $heap) (i32.mul (memory.size) (i32.const 65536))))
+        (if (i32.eq (memory.grow (i32.const 1)) (i32.const -1)) (then unreachable))
+        (br $grow)))
+    (i32.store (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (local.get $region))
+
+  (func (export "deallocate") (param i32))
+
+  (func $copy (param $dst i32) (param $src i32) (param $len i32)
+    (block $done
+      (loop $next
+        (br_if $done (i32.eqz (local.get $len)))
+        (i32.store8 (local.get $dst) (i32.load8_u (local.get $src)))
+        (local.set $dst (i32.add (local.get $dst) (i32.const 1)))
+        (local.set $src (i32.add (local.get $src) (i32.const 1)))
+        (local.set $len (i32.sub (local.get $len) (i32.const 1)))
+        (br $next))))
+
+  ;; concat returns a region holding the three given slices
+  (func $concat (param $p1 i32) (param $l1 i32) (param $p2 i32) (param $l2 i32) (param $p3 i32) (param $l3 i32) (result i32)
+    (local $region i32)
+    (local $data i32)
+    (local.set $region (call $allocate (i32.add (local.get $l1) (i32.add (local.get $l2) (local.get $l3)))))
+    (local.set $data (i32.load (local.get $region)))
+    (call $copy (local.get $data) (local.get $p1) (local.get $l1))
+    (call $copy (i32.add (local.get $data) (local.get $l1)) (local.get $p2) (local.get $l2))
+    (call $copy (i32.add (local.get $data) (i32.add (local.get $l1) (local.get $l2))) (local.get $p3) (local.get $l3))
+    (i32.store offset=8 (local.get $region) (i32.load offset=4 (local.get $region)))
+    (local.get $region))
+
+  (func (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (call $concat (i32.const 16) (i32.const 62) (i32.const 0) (i32.const 0) (i32.const 0) (i32.const 0)))
+
+  ;; execute dispatches the message as a custom message
+  (func (export "execute") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (call $concat
+      (i32.const 80) (i32.const 43)
+      (i32.load (local.get $msg)) (i32.load offset=8 (local.get $msg))
+      (i32.const 128) (i32.const 81)))
+
+  ;; query sends the message as a custom query, and returns the inner result
+  ;; of the {"ok":<result>} returned by the chain
+  (func (export "query") (param $env i32) (param $msg i32) (result i32)
+    (local $res i32)
+    (local $data i32)
+    (local.set $res
+      (call $query_chain
+        (call $concat
+          (i32.const 216) (i32.const 10)
+          (i32.load (local.get $msg)) (i32.load offset=8 (local.get $msg))
+          (i32.const 232) (i32.const 1))))
+    (local.set $data (i32.load (local.get $res)))
+    (if (result i32) (i32.eq (i32.load8_u offset=2 (local.get $data)) (i32.const 111))
+      (then
+        (call $concat
+          (i32.add (local.get $data) (i32.const 6)) (i32.sub (i32.load offset=8 (local.get $res)) (i32.const 7))
+          (i32.const 0) (i32.const 0) (i32.const 0) (i32.const 0)))
+      (else
+        (call $concat (i32.const 240) (i32.const 24) (i32.const 0) (i32.const 0) (i32.const 0) (i32.const 0)))))
+)
@@ -21,16 +21,23 @@ func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator,
 
 		ctx.Logger().Info("Updating fantoken fees")
 		ftParams := ftk.GetParamSet(ctx)
-		ftParams.IssueFee.Denom = appparams.DefaultBondDenom
-		ftParams.MintFee.Denom = appparams.DefaultBondDenom
-		ftParams.BurnFee.Denom = appparams.DefaultBondDenom
+		setBondDenom(ftParams.IssueFee)
+		setBondDenom(ftParams.MintFee)
+		setBondDenom(ftParams.BurnFee)
 		ftk.SetParamSet(ctx, ftParams)
 
 		ctx.Logger().Info("Updating merkledrop fees")
 		mParams := mk.GetParamSet(ctx)
-		mParams.CreationFee.Denom = appparams.DefaultBondDenom
+		setBondDenom(mParams.CreationFee)
 		mk.SetParamSet(ctx, mParams)
 
 		return newVM, err
 	}
 }
+
+// setBondDenom sets the bond denom on every fee
+func setBondDenom(fees []sdk.Coin) {
+	for i := range fees {
+		fees[i].Denom = appparams.DefaultBondDenom
+	}
+}
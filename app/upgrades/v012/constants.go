@@ -0,0 +1,5 @@
+package v012
+
+const (
+	UpgradeName = "v012"
+)
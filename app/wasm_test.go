@@ -0,0 +1,227 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/bitsongofficial/go-bitsong/wasmbinding/bindings"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+func fundAccount(t *testing.T, app *BitsongApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+func executeCustom(t *testing.T, app *BitsongApp, ctx sdk.Context, contract, sender sdk.AccAddress, msg bindings.FanTokenMsg) error {
+	bz, err := json.Marshal(bindings.BitsongMsg{FanToken: &msg})
+	require.NoError(t, err)
+
+	_, err = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper).Execute(ctx, contract, sender, bz, nil)
+	return err
+}
+
+func TestFanTokenBindings(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "bitsong-test-1", Height: 1, Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress(tmhash.SumTruncated([]byte("creator")))
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	// store and instantiate the reflect contract
+	wasmCode, err := os.ReadFile("testdata/fantoken_reflect.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+	codeID, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, []byte("{}"), "fantoken reflect", nil)
+	require.NoError(t, err)
+
+	// the contract pays the issue fee
+	issue := bindings.FanTokenMsg{Issue: &bindings.Issue{
+		Name:      "fan club token",
+		Symbol:    "club",
+		URI:       "ipfs://club",
+		MaxSupply: sdk.NewInt(1_000),
+	}}
+	require.Error(t, executeCustom(t, app, ctx, contract, creator, issue))
+
+	fundAccount(t, app, ctx, contract, sdk.NewCoins(app.FanTokenKeeper.GetParamSet(ctx).IssueFee...))
+	require.NoError(t, executeCustom(t, app, ctx, contract, creator, issue))
+
+	fantokens := app.FanTokenKeeper.GetFanTokensByMinter(ctx, contract)
+	require.Len(t, fantokens, 1)
+	denom := fantokens[0].Denom
+	require.Equal(t, contract.String(), fantokens[0].MetaData.Authority)
+
+	// mint to the recipient and to the contract
+	require.NoError(t, executeCustom(t, app, ctx, contract, creator, bindings.FanTokenMsg{Mint: &bindings.Mint{
+		Coin:      wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewInt64Coin(denom, 100)),
+		Recipient: recipient.String(),
+	}}))
+	require.NoError(t, executeCustom(t, app, ctx, contract, creator, bindings.FanTokenMsg{Mint: &bindings.Mint{
+		Coin: wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewInt64Coin(denom, 50)),
+	}}))
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+
+	// the contract burns its own fantokens
+	require.NoError(t, executeCustom(t, app, ctx, contract, creator, bindings.FanTokenMsg{Burn: &bindings.Burn{
+		Coin: wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewInt64Coin(denom, 20)),
+	}}))
+	require.Equal(t, sdk.NewInt(30), app.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+
+	// the contract queries the fantoken
+	query, err := json.Marshal(bindings.BitsongQuery{FanToken: &bindings.FanTokenQuery{
+		FanToken: &bindings.FanTokenRequest{Denom: denom},
+	}})
+	require.NoError(t, err)
+
+	bz, err := app.WasmKeeper.QuerySmart(ctx, contract, query)
+	require.NoError(t, err)
+
+	var res bindings.FanTokenResponse
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, bindings.FanToken{
+		Denom:     denom,
+		Name:      "fan club token",
+		Symbol:    "club",
+		URI:       "ipfs://club",
+		MaxSupply: sdk.NewInt(1_000),
+		Minter:    contract.String(),
+		Authority: contract.String(),
+	}, res.FanToken)
+
+	query = []byte(fmt.Sprintf(`{"fantoken":{"fan_token":{"denom":"%s"}}}`, "ftunknown"))
+	_, err = app.WasmKeeper.QuerySmart(ctx, contract, query)
+	require.Error(t, err)
+
+	// once the minter is transferred, the contract cannot mint anymore
+	require.NoError(t, executeCustom(t, app, ctx, contract, creator, bindings.FanTokenMsg{SetMinter: &bindings.SetMinter{
+		Denom:     denom,
+		NewMinter: recipient.String(),
+	}}))
+	require.Error(t, executeCustom(t, app, ctx, contract, creator, bindings.FanTokenMsg{Mint: &bindings.Mint{
+		Coin: wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewInt64Coin(denom, 1)),
+	}}))
+
+	fantoken, err := app.FanTokenKeeper.GetFanToken(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, recipient.String(), fantoken.Minter)
+}
+
+func TestFanTokenPauseWasm(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "bitsong-test-1", Height: 1, Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress(tmhash.SumTruncated([]byte("creator")))
+
+	wasmCode, err := os.ReadFile("testdata/fantoken_reflect.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+	codeID, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, []byte("{}"), "fantoken reflect", nil)
+	require.NoError(t, err)
+
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.IssueFee = nil
+	params.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", sdk.NewInt(1_000), creator, creator, 0, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, app.FanTokenKeeper.Mint(ctx, creator, creator, sdk.NewInt64Coin(denom, 100), ""))
+	require.NoError(t, app.FanTokenKeeper.Pause(ctx, denom, creator))
+
+	// the paused fantoken cannot be sent to the contract as funds
+	burn, err := json.Marshal(bindings.BitsongMsg{FanToken: &bindings.FanTokenMsg{Burn: &bindings.Burn{
+		Coin: wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewInt64Coin(denom, 10)),
+	}}})
+	require.NoError(t, err)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	_, err = contractKeeper.Execute(ctx, contract, creator, burn, funds)
+	require.ErrorIs(t, err, fantokentypes.ErrFanTokenPaused)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, creator, denom).Amount)
+
+	// once unpaused, the contract receives and burns the funds
+	require.NoError(t, app.FanTokenKeeper.Unpause(ctx, denom, creator))
+	_, err = contractKeeper.Execute(ctx, contract, creator, burn, funds)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(90), app.BankKeeper.GetBalance(ctx, creator, denom).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, contract, denom).IsZero())
+}
+
+func TestHolderAuthorizationWasm(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "bitsong-test-1", Height: 1, Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress(tmhash.SumTruncated([]byte("creator")))
+	granter := sdk.AccAddress(tmhash.SumTruncated([]byte("granter")))
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	wasmCode, err := os.ReadFile("testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+	codeID, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.IssueFee = nil
+	params.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", sdk.NewInt(1_000), creator, creator, 0, nil, "")
+	require.NoError(t, err)
+
+	// the granter allows the holders of 50 fantokens to send its coins
+	fundAccount(t, app, ctx, granter, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	authorization := fantokentypes.NewHolderAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), denom, sdk.NewInt(50))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, contract, granter, authorization, ctx.BlockTime().Add(time.Hour)))
+
+	// the contract executes the grant with a stargate message
+	send := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	exec := authz.NewMsgExec(contract, []sdk.Msg{send})
+	value, err := app.AppCodec().Marshal(&exec)
+	require.NoError(t, err)
+	msg, err := json.Marshal(wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{
+		TypeURL: sdk.MsgTypeURL(&exec),
+		Value:   value,
+	}})
+	require.NoError(t, err)
+
+	// the contract holds less than the threshold
+	require.NoError(t, app.FanTokenKeeper.Mint(ctx, creator, contract, sdk.NewInt64Coin(denom, 49), ""))
+	_, err = contractKeeper.Execute(ctx, contract, creator, msg, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).IsZero())
+
+	// the contract holds the threshold
+	require.NoError(t, app.FanTokenKeeper.Mint(ctx, creator, contract, sdk.NewInt64Coin(denom, 1), ""))
+	_, err = contractKeeper.Execute(ctx, contract, creator, msg, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount)
+}
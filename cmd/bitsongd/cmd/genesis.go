@@ -115,11 +115,11 @@ func MainnetGenesisParams() GenesisParams {
 	genParams.CrisisConstantFee = sdk.NewCoin(appparams.MicroCoinUnit, sdk.NewInt(133_333_000_000))
 
 	genParams.FantokenParams = fantokentypes.DefaultParams()
-	genParams.FantokenParams.IssueFee = sdk.NewCoin(appparams.MicroCoinUnit, sdk.NewInt(1_000_000_000))
-	genParams.FantokenParams.MintFee = sdk.NewCoin(appparams.MicroCoinUnit, sdk.ZeroInt())
-	genParams.FantokenParams.BurnFee = sdk.NewCoin(appparams.MicroCoinUnit, sdk.ZeroInt())
+	genParams.FantokenParams.IssueFee = []sdk.Coin{sdk.NewCoin(appparams.MicroCoinUnit, sdk.NewInt(1_000_000_000))}
+	genParams.FantokenParams.MintFee = []sdk.Coin{sdk.NewCoin(appparams.MicroCoinUnit, sdk.ZeroInt())}
+	genParams.FantokenParams.BurnFee = []sdk.Coin{sdk.NewCoin(appparams.MicroCoinUnit, sdk.ZeroInt())}
 
-	genParams.MerkledropParams.CreationFee = sdk.NewCoin(appparams.MicroCoinUnit, sdk.NewInt(500_000_000))
+	genParams.MerkledropParams.CreationFee = []sdk.Coin{sdk.NewCoin(appparams.MicroCoinUnit, sdk.NewInt(500_000_000))}
 
 	return genParams
 }
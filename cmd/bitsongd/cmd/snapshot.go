@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+const (
+	flagTotalAmount = "total-amount"
+)
+
+// SnapshotCmd returns the snapshot cobra Command, taking the snapshots of the
+// balances from an exported genesis.
+func SnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Take a snapshot of the balances from an exported genesis",
+	}
+
+	cmd.AddCommand(
+		SnapshotFanTokenHoldersCmd(),
+	)
+
+	return cmd
+}
+
+// SnapshotFanTokenHoldersCmd returns the fantoken-holders cobra Command.
+func SnapshotFanTokenHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fantoken-holders [genesis-file] [denom] [output-file]",
+		Short: "Write the holders of a fantoken to the accounts file of a merkledrop",
+		Long: `Read the balances of a fantoken from an exported genesis and write them to
+the accounts file consumed by "tx merkledrop create", mapping each holder to its amount.
+The module accounts are not included, since they cannot claim a merkledrop, nor the
+escrow accounts of the IBC channels of the genesis, such as the ICS-20 transfer and the
+ibcfantoken escrows, whose balances belong to the holders of the vouchers on the
+counterparty chains.
+
+With the --total-amount flag the balances are scaled pro-rata, so that the holders share
+the total amount. The scaled amounts are truncated, so their sum can be lower than the
+total amount, and the holders of a too small balance are not included.
+`,
+		Example: fmt.Sprintf(`$ %[1]s export --height <height> 2> genesis.json
+$ %[1]s snapshot fantoken-holders genesis.json <denom> accounts.json --total-amount 1000000000
+$ %[1]s tx merkledrop create accounts.json out-list.json --denom ubtsg --from <key-name>`,
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec.(codec.Codec)
+
+			if err := fantokentypes.ValidateDenom(args[1]); err != nil {
+				return err
+			}
+
+			var totalAmount sdk.Int
+			totalAmountStr, err := cmd.Flags().GetString(flagTotalAmount)
+			if err != nil {
+				return err
+			}
+			if len(totalAmountStr) > 0 {
+				var ok bool
+				totalAmount, ok = sdk.NewIntFromString(totalAmountStr)
+				if !ok || !totalAmount.IsPositive() {
+					return fmt.Errorf("invalid total amount %s, it must be a positive integer", totalAmountStr)
+				}
+			}
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			accounts, total, err := SnapshotFanTokenHolders(cdc, appState, args[1], totalAmount)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(accounts, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(args[2], bz, 0644); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("%d holders of %s written to %s, for a total amount of %s\n", len(accounts), args[1], args[2], total)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTotalAmount, "", "Scale the balances pro-rata to the total amount")
+
+	return cmd
+}
+
+// SnapshotFanTokenHolders returns the amounts of the holders of the fantoken in
+// the app state, scaled pro-rata to the total amount when it is not nil, and
+// their sum. The module accounts and the escrow accounts of the IBC channels
+// are left out.
+func SnapshotFanTokenHolders(cdc codec.Codec, appState map[string]json.RawMessage, denom string, totalAmount sdk.Int) (map[string]string, sdk.Int, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	genAccounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, sdk.Int{}, fmt.Errorf("failed to get accounts from genesis state: %w", err)
+	}
+
+	excluded := make(map[string]bool)
+	for _, acc := range genAccounts {
+		if _, ok := acc.(authtypes.ModuleAccountI); ok {
+			excluded[acc.GetAddress().String()] = true
+		}
+	}
+
+	escrows, err := channelEscrowAddresses(cdc, appState)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+	for _, escrow := range escrows {
+		excluded[escrow.String()] = true
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	balances := make(map[string]sdk.Int)
+	supply := sdk.ZeroInt()
+	for _, balance := range bankGenState.Balances {
+		if excluded[balance.Address] {
+			continue
+		}
+
+		amount := balance.Coins.AmountOf(denom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		balances[balance.Address] = amount
+		supply = supply.Add(amount)
+	}
+
+	if supply.IsZero() {
+		return nil, sdk.Int{}, fmt.Errorf("no holders of %s found", denom)
+	}
+
+	accounts := make(map[string]string, len(balances))
+	total := sdk.ZeroInt()
+	for address, amount := range balances {
+		if !totalAmount.IsNil() {
+			amount = amount.Mul(totalAmount).Quo(supply)
+			if amount.IsZero() {
+				continue
+			}
+		}
+
+		accounts[address] = amount.String()
+		total = total.Add(amount)
+	}
+
+	return accounts, total, nil
+}
+
+// channelEscrowAddresses returns the escrow addresses of the IBC channels in
+// the app state. Both the ICS-20 transfer and the ibcfantoken modules escrow
+// the coins in the address derived from the port and the channel
+func channelEscrowAddresses(cdc codec.Codec, appState map[string]json.RawMessage) ([]sdk.AccAddress, error) {
+	bz, ok := appState[ibchost.ModuleName]
+	if !ok {
+		return nil, nil
+	}
+
+	var ibcGenState ibctypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &ibcGenState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ibc genesis state: %w", err)
+	}
+
+	escrows := make([]sdk.AccAddress, 0, len(ibcGenState.ChannelGenesis.Channels))
+	for _, channel := range ibcGenState.ChannelGenesis.Channels {
+		escrows = append(escrows, transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId))
+	}
+	return escrows, nil
+}
@@ -0,0 +1,133 @@
+package cmd_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/bitsongofficial/go-bitsong/app"
+	"github.com/bitsongofficial/go-bitsong/cmd/bitsongd/cmd"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	ibcfantokentypes "github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+func TestSnapshotFanTokenHolders(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	denom := "ft2D8E7041556CE93E1EFD66C07C45D551A6AAAE09"
+
+	holder1 := sdk.AccAddress(tmhash.SumTruncated([]byte("holder1")))
+	holder2 := sdk.AccAddress(tmhash.SumTruncated([]byte("holder2")))
+	holder3 := sdk.AccAddress(tmhash.SumTruncated([]byte("holder3")))
+	moduleAccount := authtypes.NewEmptyModuleAccount(fantokentypes.ModuleName)
+	transferEscrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	ibcFanTokenEscrow := transfertypes.GetEscrowAddress(ibcfantokentypes.PortID, "channel-1")
+
+	// a small genesis with the fantoken held by accounts, a module account
+	// and the escrows of the channels
+	appState := app.NewDefaultGenesisState()
+
+	authGenState := authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		authtypes.NewBaseAccountWithAddress(holder1),
+		moduleAccount,
+	})
+	appState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenState)
+
+	balance := func(addr sdk.AccAddress, coins ...sdk.Coin) banktypes.Balance {
+		return banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(coins...)}
+	}
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{
+		balance(holder1, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		balance(holder2, sdk.NewInt64Coin(denom, 200)),
+		balance(holder3, sdk.NewInt64Coin(denom, 1)),
+		balance(moduleAccount.GetAddress(), sdk.NewInt64Coin(denom, 1000)),
+		balance(transferEscrow, sdk.NewInt64Coin(denom, 500), sdk.NewInt64Coin("ftescrowed", 10)),
+		balance(ibcFanTokenEscrow, sdk.NewInt64Coin(denom, 300)),
+	}
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	ibcGenState := ibctypes.DefaultGenesisState()
+	ibcGenState.ChannelGenesis.Channels = []channeltypes.IdentifiedChannel{
+		channeltypes.NewIdentifiedChannel(transfertypes.PortID, "channel-0", channeltypes.Channel{}),
+		channeltypes.NewIdentifiedChannel(ibcfantokentypes.PortID, "channel-1", channeltypes.Channel{}),
+	}
+	appState[ibchost.ModuleName] = cdc.MustMarshalJSON(ibcGenState)
+
+	for _, tc := range []struct {
+		desc        string
+		denom       string
+		totalAmount sdk.Int
+		accounts    map[string]string
+		total       sdk.Int
+		expErr      bool
+	}{
+		{
+			desc:  "balances",
+			denom: denom,
+			accounts: map[string]string{
+				holder1.String(): "100",
+				holder2.String(): "200",
+				holder3.String(): "1",
+			},
+			total: sdk.NewInt(301),
+		},
+		{
+			desc:        "pro-rata amounts are truncated",
+			denom:       denom,
+			totalAmount: sdk.NewInt(1000),
+			accounts: map[string]string{
+				holder1.String(): "332",
+				holder2.String(): "664",
+				holder3.String(): "3",
+			},
+			total: sdk.NewInt(999),
+		},
+		{
+			desc:        "holders truncated to zero are left out",
+			denom:       denom,
+			totalAmount: sdk.NewInt(100),
+			accounts: map[string]string{
+				holder1.String(): "33",
+				holder2.String(): "66",
+			},
+			total: sdk.NewInt(99),
+		},
+		{
+			desc:   "escrowed only",
+			denom:  "ftescrowed",
+			expErr: true,
+		},
+		{
+			desc:   "no holders",
+			denom:  "ftunknown",
+			expErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			accounts, total, err := cmd.SnapshotFanTokenHolders(cdc, appState, tc.denom, tc.totalAmount)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.accounts, accounts)
+			require.Equal(t, tc.total, total)
+		})
+	}
+
+	// the app state without the ibc genesis has no escrow to leave out
+	delete(appState, ibchost.ModuleName)
+	accounts, _, err := cmd.SnapshotFanTokenHolders(cdc, appState, denom, sdk.Int{})
+	require.NoError(t, err)
+	require.Equal(t, "500", accounts[transferEscrow.String()])
+	require.NotContains(t, accounts, moduleAccount.GetAddress().String())
+}
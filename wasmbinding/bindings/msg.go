@@ -0,0 +1,58 @@
+package bindings
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BitsongMsg is the custom message dispatched by the contracts
+type BitsongMsg struct {
+	FanToken *FanTokenMsg `json:"fantoken,omitempty"`
+}
+
+// FanTokenMsg lists the fantoken operations available to the contracts. The
+// contract sending the message is the authority of the fantokens it issues,
+// and the minter unless another one is set
+type FanTokenMsg struct {
+	Issue     *Issue     `json:"issue,omitempty"`
+	Mint      *Mint      `json:"mint,omitempty"`
+	Burn      *Burn      `json:"burn,omitempty"`
+	SetMinter *SetMinter `json:"set_minter,omitempty"`
+}
+
+// Issue issues a new fantoken
+type Issue struct {
+	Name      string  `json:"name"`
+	Symbol    string  `json:"symbol"`
+	URI       string  `json:"uri"`
+	MaxSupply sdk.Int `json:"max_supply"`
+	// Minter is the contract itself when empty
+	Minter   string `json:"minter,omitempty"`
+	FeeDenom string `json:"fee_denom,omitempty"`
+}
+
+// IssueResponse is returned as the data of the Issue message
+type IssueResponse struct {
+	Denom string `json:"denom"`
+}
+
+// Mint mints a fantoken whose minter is the contract
+type Mint struct {
+	Coin wasmvmtypes.Coin `json:"coin"`
+	// Recipient is the contract itself when empty
+	Recipient string `json:"recipient,omitempty"`
+	FeeDenom  string `json:"fee_denom,omitempty"`
+}
+
+// Burn burns the fantokens owned by the contract
+type Burn struct {
+	Coin     wasmvmtypes.Coin `json:"coin"`
+	FeeDenom string           `json:"fee_denom,omitempty"`
+}
+
+// SetMinter transfers the minting capability of a fantoken whose minter is
+// the contract
+type SetMinter struct {
+	Denom     string `json:"denom"`
+	NewMinter string `json:"new_minter"`
+}
@@ -0,0 +1,37 @@
+package bindings
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// BitsongQuery is the custom query sent by the contracts
+type BitsongQuery struct {
+	FanToken *FanTokenQuery `json:"fantoken,omitempty"`
+}
+
+// FanTokenQuery lists the fantoken queries available to the contracts
+type FanTokenQuery struct {
+	FanToken *FanTokenRequest `json:"fan_token,omitempty"`
+}
+
+// FanTokenRequest queries a fantoken by denom
+type FanTokenRequest struct {
+	Denom string `json:"denom"`
+}
+
+// FanTokenResponse is the response of the FanTokenRequest
+type FanTokenResponse struct {
+	FanToken FanToken `json:"fantoken"`
+}
+
+// FanToken is the contract view of a fantoken, the minter is empty once the
+// minting is disabled
+type FanToken struct {
+	Denom          string  `json:"denom"`
+	Name           string  `json:"name"`
+	Symbol         string  `json:"symbol"`
+	URI            string  `json:"uri"`
+	MaxSupply      sdk.Int `json:"max_supply"`
+	Minter         string  `json:"minter"`
+	Authority      string  `json:"authority"`
+	RoyaltyBps     uint32  `json:"royalty_bps"`
+	RoyaltyAddress string  `json:"royalty_address"`
+}
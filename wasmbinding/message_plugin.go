@@ -0,0 +1,155 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitsongofficial/go-bitsong/wasmbinding/bindings"
+	fantokenkeeper "github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// CustomMessageDecorator returns the decorator of the wasm messenger handling
+// the BitsongMsg of the contracts
+func CustomMessageDecorator(fantokenKeeper *fantokenkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:        old,
+			fantokenKeeper: fantokenKeeper,
+		}
+	}
+}
+
+// CustomMessenger dispatches the BitsongMsg, and passes the other messages to
+// the wrapped messenger
+type CustomMessenger struct {
+	wrapped        wasmkeeper.Messenger
+	fantokenKeeper *fantokenkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var customMsg bindings.BitsongMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "bitsong msg")
+	}
+
+	if customMsg.FanToken != nil {
+		return m.dispatchFanTokenMsg(ctx, contractAddr, customMsg.FanToken)
+	}
+
+	return nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown bitsong msg variant"}
+}
+
+func (m *CustomMessenger) dispatchFanTokenMsg(ctx sdk.Context, contractAddr sdk.AccAddress, msg *bindings.FanTokenMsg) ([]sdk.Event, [][]byte, error) {
+	switch {
+	case msg.Issue != nil:
+		return m.issue(ctx, contractAddr, msg.Issue)
+	case msg.Mint != nil:
+		return m.mint(ctx, contractAddr, msg.Mint)
+	case msg.Burn != nil:
+		return m.burn(ctx, contractAddr, msg.Burn)
+	case msg.SetMinter != nil:
+		return m.setMinter(ctx, contractAddr, msg.SetMinter)
+	default:
+		return nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown fantoken msg variant"}
+	}
+}
+
+func (m *CustomMessenger) issue(ctx sdk.Context, contractAddr sdk.AccAddress, issue *bindings.Issue) ([]sdk.Event, [][]byte, error) {
+	if issue.MaxSupply.IsNil() {
+		return nil, nil, sdkerrors.Wrap(fantokentypes.ErrInvalidMaxSupply, "max supply is required")
+	}
+
+	msg := fantokentypes.NewMsgIssue(issue.Name, issue.Symbol, issue.URI, issue.MaxSupply, contractAddr.String())
+	msg.Minter = contractAddr.String()
+	if issue.Minter != "" {
+		msg.Minter = issue.Minter
+	}
+	msg.FeeDenom = issue.FeeDenom
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "issuing fantoken")
+	}
+
+	res, err := fantokenkeeper.NewMsgServerImpl(m.fantokenKeeper).Issue(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "issuing fantoken")
+	}
+
+	data, err := json.Marshal(bindings.IssueResponse{Denom: res.Denom})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "issuing fantoken")
+	}
+
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) mint(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.Mint) ([]sdk.Event, [][]byte, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(mint.Coin)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "minting fantoken")
+	}
+
+	recipient := contractAddr.String()
+	if mint.Recipient != "" {
+		recipient = mint.Recipient
+	}
+
+	msg := fantokentypes.NewMsgMint(recipient, coin, contractAddr.String())
+	msg.FeeDenom = mint.FeeDenom
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "minting fantoken")
+	}
+
+	if _, err := fantokenkeeper.NewMsgServerImpl(m.fantokenKeeper).Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "minting fantoken")
+	}
+
+	return nil, nil, nil
+}
+
+func (m *CustomMessenger) burn(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindings.Burn) ([]sdk.Event, [][]byte, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(burn.Coin)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "burning fantoken")
+	}
+
+	msg := fantokentypes.NewMsgBurn(coin, contractAddr.String())
+	msg.FeeDenom = burn.FeeDenom
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "burning fantoken")
+	}
+
+	if _, err := fantokenkeeper.NewMsgServerImpl(m.fantokenKeeper).Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "burning fantoken")
+	}
+
+	return nil, nil, nil
+}
+
+func (m *CustomMessenger) setMinter(ctx sdk.Context, contractAddr sdk.AccAddress, setMinter *bindings.SetMinter) ([]sdk.Event, [][]byte, error) {
+	msg := fantokentypes.NewMsgSetMinter(setMinter.Denom, contractAddr.String(), setMinter.NewMinter)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "setting fantoken minter")
+	}
+
+	if _, err := fantokenkeeper.NewMsgServerImpl(m.fantokenKeeper).SetMinter(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "setting fantoken minter")
+	}
+
+	return nil, nil, nil
+}
@@ -0,0 +1,58 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bitsongofficial/go-bitsong/wasmbinding/bindings"
+	fantokenkeeper "github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+)
+
+// CustomQuerier dispatches the BitsongQuery of the contracts
+func CustomQuerier(fantokenKeeper *fantokenkeeper.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query bindings.BitsongQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(err, "bitsong query")
+		}
+
+		switch {
+		case query.FanToken != nil && query.FanToken.FanToken != nil:
+			return queryFanToken(ctx, fantokenKeeper, query.FanToken.FanToken)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown bitsong query variant"}
+		}
+	}
+}
+
+func queryFanToken(ctx sdk.Context, fantokenKeeper *fantokenkeeper.Keeper, req *bindings.FanTokenRequest) ([]byte, error) {
+	fantoken, err := fantokenKeeper.GetFanToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := bindings.FanTokenResponse{
+		FanToken: bindings.FanToken{
+			Denom:          fantoken.Denom,
+			Name:           fantoken.MetaData.Name,
+			Symbol:         fantoken.MetaData.Symbol,
+			URI:            fantoken.MetaData.URI,
+			MaxSupply:      fantoken.MaxSupply,
+			Minter:         fantoken.Minter,
+			Authority:      fantoken.MetaData.Authority,
+			RoyaltyBps:     fantoken.RoyaltyBps,
+			RoyaltyAddress: fantoken.RoyaltyAddress,
+		},
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "fantoken query response")
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,24 @@
+package wasmbinding
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	fantokenkeeper "github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options binding the custom
+// messages and queries of the contracts to the bitsong modules
+func RegisterCustomPlugins(fantokenKeeper *fantokenkeeper.Keeper) []wasm.Option {
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(fantokenKeeper),
+	})
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(fantokenKeeper),
+	)
+
+	return []wasm.Option{
+		queryPluginOpt,
+		messengerDecoratorOpt,
+	}
+}
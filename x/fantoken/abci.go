@@ -0,0 +1,11 @@
+package fantoken
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker releases the unlocked amounts of the mint schedules
+func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	keeper.ReleaseMintSchedules(ctx)
+}
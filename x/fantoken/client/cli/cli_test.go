@@ -421,9 +421,9 @@ func (s *IntegrationTestSuite) TestCmdQueryParams() {
 	resp, err := clitestutil.ExecTestCLICmd(clientCtx, tokencli.GetCmdQueryParams(), args)
 	s.Require().NoError(err)
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &params))
-	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)), params.IssueFee)
-	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), params.MintFee)
-	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), params.BurnFee)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))}, params.IssueFee)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}, params.MintFee)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}, params.BurnFee)
 }
 
 /*
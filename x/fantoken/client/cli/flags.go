@@ -13,15 +13,24 @@ const (
 	FlagNewMinter    = "new-minter"
 	FlagAmount       = "amount"
 	FlagURI          = "uri"
+	FlagScheduleType = "schedule-type"
+	FlagStartTime    = "start-time"
+	FlagEndTime      = "end-time"
+
+	FlagRoyaltyBps     = "royalty-bps"
+	FlagRoyaltyAddress = "royalty-address"
+	FlagFeeDenom       = "fee-denom"
 )
 
 var (
 	FsIssue        = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMint         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBurn         = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDisableMint  = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSetAuthority = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSetMinter    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSetUri       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMintSchedule = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -29,8 +38,14 @@ func init() {
 	FsIssue.String(FlagName, "", "The fantoken name, e.g. Bitsong Network")
 	FsIssue.String(FlagMaxSupply, "", "The maximum supply of the fantoken")
 	FsIssue.String(FlagURI, "", "The fantoken uri")
+	FsIssue.Uint32(FlagRoyaltyBps, 0, "The royalty, in basis points, collected on every transfer of the fantoken")
+	FsIssue.String(FlagRoyaltyAddress, "", "The address receiving the royalties, the authority if empty")
+	FsIssue.String(FlagFeeDenom, "", "The denom of the accepted issue fee to pay, the first accepted fee if empty")
 
 	FsMint.String(FlagRecipient, "", "Address to which the fantoken is to be minted")
+	FsMint.String(FlagFeeDenom, "", "The denom of the accepted mint fee to pay, the first accepted fee if empty")
+
+	FsBurn.String(FlagFeeDenom, "", "The denom of the accepted burn fee to pay, the first accepted fee if empty")
 
 	FsDisableMint.String(FlagName, "[do-not-modify]", "The fantoken name, e.g. IRIS Network")
 	FsDisableMint.String(FlagMaxSupply, "", "The maximum supply of the fantoken")
@@ -40,4 +55,9 @@ func init() {
 	FsSetMinter.String(FlagNewMinter, "", "The new minter")
 
 	FsSetUri.String(FlagURI, "", "The uri of the fantoken")
+
+	FsMintSchedule.String(FlagScheduleType, "linear", "The schedule type (linear|cliff)")
+	FsMintSchedule.String(FlagStartTime, "", "The start time of the schedule, in RFC3339 format")
+	FsMintSchedule.String(FlagEndTime, "", "The end time of the schedule, in RFC3339 format")
+	FsMintSchedule.String(FlagFeeDenom, "", "The denom of the accepted mint fee to pay, the first accepted fee if empty")
 }
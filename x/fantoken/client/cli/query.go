@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,14 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryFanToken(),
 		GetCmdQueryFanTokens(),
+		GetCmdQueryFanTokensBySymbol(),
+		GetCmdQueryFanTokensByMinter(),
+		GetCmdQueryPaused(),
+		GetCmdQueryMintSchedule(),
+		GetCmdQueryMintSchedules(),
+		GetCmdQueryCheckHolder(),
+		GetCmdQueryCheckHolders(),
+		GetCmdQueryHolders(),
 		GetCmdQueryParams(),
 	)
 
@@ -107,6 +116,295 @@ func GetCmdQueryFanTokens() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryFanTokensBySymbol implements the query fantokens by symbol command.
+func GetCmdQueryFanTokensBySymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "symbol [symbol]",
+		Short:   "Query fantokens by the symbol.",
+		Example: fmt.Sprintf("$ %s query fantoken symbol <symbol>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FanTokensBySymbol(context.Background(), &types.QueryFanTokensBySymbolRequest{
+				Symbol:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fantokens by symbol")
+
+	return cmd
+}
+
+// GetCmdQueryFanTokensByMinter implements the query fantokens by minter command.
+func GetCmdQueryFanTokensByMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "minter [minter]",
+		Short:   "Query fantokens by the minter.",
+		Example: fmt.Sprintf("$ %s query fantoken minter <minter>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FanTokensByMinter(context.Background(), &types.QueryFanTokensByMinterRequest{
+				Minter:     minter.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fantokens by minter")
+
+	return cmd
+}
+
+// GetCmdQueryPaused implements the query fantoken paused command.
+func GetCmdQueryPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused [denom]",
+		Short:   "Query whether the transfers of a fantoken are paused.",
+		Example: fmt.Sprintf("$ %s query fantoken paused <denom>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Paused(context.Background(), &types.QueryPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCheckHolder implements the query check holder command.
+func GetCmdQueryCheckHolder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "check-holder [address] [denom] [min-amount]",
+		Short:   "Query whether an address holds at least the min amount of a fantoken, at the --height if set.",
+		Example: fmt.Sprintf("$ %s query fantoken check-holder <address> <denom> 1000000", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to parse min amount: %s", args[2])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CheckHolder(context.Background(), &types.QueryCheckHolderRequest{
+				Address:   args[0],
+				Denom:     args[1],
+				MinAmount: minAmount,
+				AtHeight:  clientCtx.Height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCheckHolders implements the query check holders command.
+func GetCmdQueryCheckHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-holders [denom] [min-amount] [address]...",
+		Short: "Query whether each address holds at least the min amount of a fantoken, at the --height if set.",
+		Example: fmt.Sprintf(
+			"$ %s query fantoken check-holders <denom> 1000000 <address> <address>",
+			version.AppName,
+		),
+		Args: cobra.RangeArgs(3, 2+types.MaxCheckHolders),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("failed to parse min amount: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CheckHolders(context.Background(), &types.QueryCheckHoldersRequest{
+				Addresses: args[2:],
+				Denom:     args[0],
+				MinAmount: minAmount,
+				AtHeight:  clientCtx.Height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintSchedule implements the query mint schedule command.
+func GetCmdQueryMintSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-schedule [id]",
+		Short:   "Query a mint schedule with its pending and released amounts.",
+		Example: fmt.Sprintf("$ %s query fantoken mint-schedule <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("mint schedule id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintSchedule(context.Background(), &types.QueryMintScheduleRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHolders implements the query holders command.
+func GetCmdQueryHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holders [denom]",
+		Short:   "Query the holders of a fantoken with their balances.",
+		Example: fmt.Sprintf("$ %s query fantoken holders <denom> --height <height>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Holders(context.Background(), &types.QueryHoldersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+
+	return cmd
+}
+
+// GetCmdQueryMintSchedules implements the query mint schedules command.
+func GetCmdQueryMintSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-schedules [denom]",
+		Short:   "Query the mint schedules of a fantoken.",
+		Example: fmt.Sprintf("$ %s query fantoken mint-schedules <denom>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.MintSchedules(context.Background(), &types.QueryMintSchedulesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint schedules")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query fantoken related param command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
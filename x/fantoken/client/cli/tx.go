@@ -6,6 +6,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 
 	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 )
 
 // NewTxCmd returns the transaction commands for the fantoken module.
@@ -36,6 +38,10 @@ func NewTxCmd() *cobra.Command {
 		GetCmdSetAuthority(),
 		GetCmdSetMinter(),
 		GetCmdSetUri(),
+		GetCmdPause(),
+		GetCmdUnpause(),
+		GetCmdCreateMintSchedule(),
+		GetCmdUpdateParams(),
 		// GetCmdUpdateFantokenFees(),
 	)
 
@@ -53,6 +59,7 @@ func GetCmdIssue() *cobra.Command {
 				"--symbol=\"kitty\" "+
 				"--max-supply=\"1000000000000\" "+
 				"--uri=\"ipfs://...\" "+
+				"--royalty-bps=250 "+
 				"--from=<key-name> "+
 				"--chain-id=<chain-id> "+
 				"--fees=<fee>",
@@ -85,14 +92,29 @@ func GetCmdIssue() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("the uri field is invalid")
 			}
+			royaltyBps, err := cmd.Flags().GetUint32(FlagRoyaltyBps)
+			if err != nil {
+				return err
+			}
+			royaltyAddress, err := cmd.Flags().GetString(FlagRoyaltyAddress)
+			if err != nil {
+				return err
+			}
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
 
 			msg := &fantokentypes.MsgIssue{
-				Symbol:    symbol,
-				Name:      name,
-				MaxSupply: maxSupply,
-				Authority: authority.String(),
-				URI:       uri,
-				Minter:    authority.String(),
+				Symbol:         symbol,
+				Name:           name,
+				MaxSupply:      maxSupply,
+				Authority:      authority.String(),
+				URI:            uri,
+				Minter:         authority.String(),
+				RoyaltyBps:     royaltyBps,
+				RoyaltyAddress: royaltyAddress,
+				FeeDenom:       feeDenom,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -184,7 +206,13 @@ func GetCmdMint() *cobra.Command {
 				return err
 			}
 
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := fantokentypes.NewMsgMint(rcpt, coin, minter)
+			msg.FeeDenom = feeDenom
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -226,7 +254,13 @@ func GetCmdBurn() *cobra.Command {
 				return err
 			}
 
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
 			msg := fantokentypes.NewMsgBurn(coin, owner)
+			msg.FeeDenom = feeDenom
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -236,6 +270,7 @@ func GetCmdBurn() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FsBurn)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -379,20 +414,240 @@ func GetCmdSetUri() *cobra.Command {
 	return cmd
 }
 
+func GetCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom]",
+		Short: "Pause the transfers of the fantoken",
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken pause <denom> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			msg := fantokentypes.NewMsgPause(strings.TrimSpace(args[0]), authority)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [denom]",
+		Short: "Resume the transfers of the fantoken",
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken unpause <denom> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			msg := fantokentypes.NewMsgUnpause(strings.TrimSpace(args[0]), authority)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateMintSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-mint-schedule [recipient] [amount][denom]",
+		Short: "Lock a portion of the fantoken max supply into a release schedule in favour of the recipient.",
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken create-mint-schedule <recipient> [amount][denom] "+
+				"--schedule-type=linear "+
+				"--start-time=2023-01-01T00:00:00Z "+
+				"--end-time=2024-01-01T00:00:00Z "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minter := clientCtx.GetFromAddress().String()
+
+			coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+
+			scheduleTypeStr, err := cmd.Flags().GetString(FlagScheduleType)
+			if err != nil {
+				return err
+			}
+
+			scheduleType, err := fantokentypes.ScheduleTypeFromString(scheduleTypeStr)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := fantokentypes.NewMsgCreateMintSchedule(strings.TrimSpace(args[0]), coin, scheduleType, startTime, endTime, minter)
+			msg.FeeDenom = feeDenom
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMintSchedule)
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagEndTime)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+
+	return t.UTC(), nil
+}
+
+// GetCmdUpdateParams implements the update-params command, signed by the
+// authority allowed to update the fantoken params
+func GetCmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Short: "Replace all the fantoken params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace all the fantoken params at once. The transaction must be signed by
+the params authority, the gov module account by default.
+The new params must be supplied via a JSON file.
+Example:
+$ %s tx fantoken update-params <path/to/params.json> --from=<authority>
+Where params.json contains:
+{
+  "issue_fee": [{"denom": "ubtsg", "amount": "1000000"}],
+  "mint_fee": [],
+  "burn_fee": [],
+  "max_royalty_bps": 1000,
+  "memo_channels": []
+}
+`, version.AppName,
+			),
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx fantoken update-params [params-file] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params fantokentypes.Params
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &params); err != nil {
+				return err
+			}
+
+			msg := fantokentypes.NewMsgUpdateParams(params, clientCtx.GetFromAddress().String())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdUpdateFantokenFees() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-fantoken-fees [proposal-file]",
 		Short: "Submit an update fantoken fees proposal.",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit an update fantoken fees proposal along with an initial deposit.
-The proposal details must be supplied via a JSON file.
+The proposal details must be supplied via a JSON file. Every fee is a comma
+separated list of the accepted coins, the first one being charged when the
+payer does not choose a fee denom; an empty fee makes the action free.
 Example:
 $ %s tx gov submit-proposal update-fantoken-fees <path/to/proposal.json> --from=<key_or_address>
 Where proposal.json contains:
 {
   "title": "Update Fantoken Fees Proposal",
   "description": "update the current fees",
-  "issue_fee": "1000000ubtsg",
+  "issue_fee": "1000000ubtsg,1000000ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
   "mint_fee": "1000000ubtsg",
   "burn_fee": "1000000ubtsg",
   "deposit": "500000000ubtsg"
@@ -419,17 +674,17 @@ Where proposal.json contains:
 				return err
 			}
 
-			issueFee, err := sdk.ParseCoinNormalized(proposal.IssueFee)
+			issueFee, err := feestypes.ParseAcceptedFees(proposal.IssueFee)
 			if err != nil {
 				return err
 			}
 
-			mintFee, err := sdk.ParseCoinNormalized(proposal.MintFee)
+			mintFee, err := feestypes.ParseAcceptedFees(proposal.MintFee)
 			if err != nil {
 				return err
 			}
 
-			burnFee, err := sdk.ParseCoinNormalized(proposal.BurnFee)
+			burnFee, err := feestypes.ParseAcceptedFees(proposal.BurnFee)
 			if err != nil {
 				return err
 			}
@@ -471,3 +726,146 @@ func parseUpdateFeesProposal(cdc codec.JSONCodec, proposalFile string) (fantoken
 
 	return proposal, nil
 }
+
+func GetCmdRegisterVerifiedSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-verified-symbol [proposal-file]",
+		Short: "Submit a register verified symbol proposal.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to reserve a symbol to a fantoken, along with an initial deposit.
+Once reserved, the symbol cannot be issued anymore by the accounts which are neither the authority nor the minter of the fantoken.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal register-verified-symbol <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Register Verified Symbol Proposal",
+  "description": "reserve the adele symbol to the official fantoken",
+  "symbol": "adele",
+  "denom": "ft2D8E7041556CE93E1EFD66C07C45D551A6AAAE09",
+  "deposit": "500000000ubtsg"
+}
+`, version.AppName,
+			),
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal register-verified-symbol [proposal-file] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := fantokentypes.RegisterVerifiedSymbolProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := fantokentypes.NewRegisterVerifiedSymbolProposal(proposal.Title, proposal.Description, proposal.Symbol, proposal.Denom)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdUpdateFantokenParams implements a command handler for submitting an
+// update fantoken params proposal transaction.
+func GetCmdUpdateFantokenParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fantoken-params [proposal-file]",
+		Short: "Submit an update fantoken params proposal.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal replacing all the fantoken params, along with an initial deposit.
+The proposal is executed as a MsgUpdateParams signed by the params authority.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal update-fantoken-params <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Update Fantoken Params Proposal",
+  "description": "update the current params",
+  "params": {
+    "issue_fee": [{"denom": "ubtsg", "amount": "1000000"}],
+    "mint_fee": [],
+    "burn_fee": [],
+    "max_royalty_bps": 1000,
+    "memo_channels": ["channel-0"]
+  },
+  "deposit": "500000000ubtsg"
+}
+`, version.AppName,
+			),
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal update-fantoken-params [proposal-file] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := fantokentypes.UpdateParamsProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := fantokentypes.NewUpdateParamsProposal(proposal.Title, proposal.Description, proposal.Params)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -7,7 +7,11 @@ import (
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
-var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdUpdateFantokenFees, ProposalRESTHandler)
+var (
+	ProposalHandler               = govclient.NewProposalHandler(cli.GetCmdUpdateFantokenFees, ProposalRESTHandler)
+	VerifiedSymbolProposalHandler = govclient.NewProposalHandler(cli.GetCmdRegisterVerifiedSymbol, ProposalRESTHandler)
+	ParamsProposalHandler         = govclient.NewProposalHandler(cli.GetCmdUpdateFantokenParams, ProposalRESTHandler)
+)
 
 func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{}
@@ -21,12 +21,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			panic(err.Error())
 		}
 	}
+
+	// init paused fan tokens
+	for _, denom := range data.PausedDenoms {
+		k.SetPaused(ctx, denom)
+	}
+
+	// init mint schedules
+	k.SetLastMintScheduleId(ctx, data.LastMintScheduleId)
+	for _, schedule := range data.MintSchedules {
+		k.SetMintSchedule(ctx, schedule)
+		k.InsertMintScheduleQueue(ctx, schedule)
+	}
+
+	// init verified symbols
+	for _, vs := range data.VerifiedSymbols {
+		if err := k.RegisterVerifiedSymbol(ctx, vs.Symbol, vs.Denom); err != nil {
+			panic(err.Error())
+		}
+	}
 }
 
 // ExportGenesis outputs the genesis state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:    k.GetParamSet(ctx),
-		FanTokens: k.GetFanTokens(ctx, nil),
+		Params:             k.GetParamSet(ctx),
+		FanTokens:          k.GetFanTokens(ctx, nil),
+		PausedDenoms:       k.GetPausedDenoms(ctx),
+		MintSchedules:      k.GetMintSchedules(ctx),
+		LastMintScheduleId: k.GetLastMintScheduleId(ctx),
+		VerifiedSymbols:    k.GetVerifiedSymbols(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package fantoken
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -45,6 +47,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SetUri(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgPause:
+			res, err := msgServer.Pause(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnpause:
+			res, err := msgServer.Unpause(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateMintSchedule:
+			res, err := msgServer.CreateMintSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -57,6 +75,12 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.UpdateFeesProposal:
 			return handleUpdateFeesProposal(ctx, k, c)
 
+		case *types.RegisterVerifiedSymbolProposal:
+			return handleRegisterVerifiedSymbolProposal(ctx, k, c)
+
+		case *types.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized fantoken proposal content type: %T", c)
 		}
@@ -78,3 +102,19 @@ func handleUpdateFeesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateF
 
 	return nil
 }
+
+func handleRegisterVerifiedSymbolProposal(ctx sdk.Context, k keeper.Keeper, p *types.RegisterVerifiedSymbolProposal) error {
+	ctx.Logger().Info(fmt.Sprintf("Registering the verified symbol %s of the fantoken %s from proposal", p.Symbol, p.Denom))
+
+	return k.RegisterVerifiedSymbol(ctx, p.Symbol, p.Denom)
+}
+
+// handleUpdateParamsProposal executes the MsgUpdateParams of the proposal with
+// the params authority, so that the gov module can replace the params
+func handleUpdateParamsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateParamsProposal) error {
+	ctx.Logger().Info("Updating fantoken params from proposal")
+
+	msgServer := keeper.NewMsgServerImpl(&k)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(p.Params, k.GetAuthority()))
+	return err
+}
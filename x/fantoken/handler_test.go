@@ -42,7 +42,7 @@ func (suite *HandlerTestSuite) TestParamChangeProposal() {
 		proposal.NewParamChange(
 			fantokentypes.ModuleName,
 			string(fantokentypes.KeyMintFee),
-			"{\"denom\":\"utsg\",\"amount\":\"0\"}",
+			"[{\"denom\":\"utsg\",\"amount\":\"0\"}]",
 		),
 	)
 
@@ -59,9 +59,9 @@ func TestProposalHandlerPassed(t *testing.T) {
 	params := app.FanTokenKeeper.GetParamSet(ctx)
 	require.Equal(t, params, fantokentypes.DefaultParams())
 
-	newIssueFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))
-	newMintFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))
-	newBurnFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3))
+	newIssueFee := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), sdk.NewCoin("uusdc", sdk.NewInt(10))}
+	newMintFee := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))}
+	newBurnFee := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3))}
 
 	proposal := fantokentypes.NewUpdateFeesProposal(
 		"Test",
@@ -87,12 +87,12 @@ func TestProposalHandlerFailed(t *testing.T) {
 	params := app.FanTokenKeeper.GetParamSet(ctx)
 	require.Equal(t, params, fantokentypes.DefaultParams())
 
-	newIssueFee := sdk.Coin{
+	newIssueFee := []sdk.Coin{{
 		Denom:  sdk.DefaultBondDenom,
 		Amount: sdk.NewInt(-1),
-	}
-	newMintFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
-	newBurnFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())
+	}}
+	newMintFee := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}
+	newBurnFee := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}
 
 	proposal := fantokentypes.NewUpdateFeesProposal(
 		"Test",
@@ -105,3 +105,80 @@ func TestProposalHandlerFailed(t *testing.T) {
 	h := fantoken.NewProposalHandler(app.FanTokenKeeper)
 	require.Error(t, h(ctx, proposal))
 }
+
+func TestRegisterVerifiedSymbolProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	issuer := sdk.AccAddress("issuer______________")
+	other := sdk.AccAddress("other_______________")
+
+	// fund the issuers with the issue fees
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000_000)))
+	for _, addr := range []sdk.AccAddress{issuer, other} {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, fantokentypes.ModuleName, fees))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, fantokentypes.ModuleName, addr, fees))
+	}
+
+	maxSupply := sdk.NewInt(1_000_000)
+	denom, err := app.FanTokenKeeper.Issue(ctx, "Adele", "adele", "", maxSupply, issuer, issuer, 0, nil, "")
+	require.NoError(t, err)
+	fakeDenom, err := app.FanTokenKeeper.Issue(ctx, "Adele Official", "adele", "", maxSupply, other, other, 0, nil, "")
+	require.NoError(t, err)
+
+	h := fantoken.NewProposalHandler(app.FanTokenKeeper)
+
+	// the symbol must match the one of the fantoken
+	require.Error(t, h(ctx, fantokentypes.NewRegisterVerifiedSymbolProposal("Test", "description", "adel", denom)))
+	require.Error(t, h(ctx, fantokentypes.NewRegisterVerifiedSymbolProposal("Test", "description", "adele", "ft00")))
+
+	require.NoError(t, h(ctx, fantokentypes.NewRegisterVerifiedSymbolProposal("Test", "description", "adele", denom)))
+
+	res, err := app.FanTokenKeeper.FanToken(sdk.WrapSDKContext(ctx), &fantokentypes.QueryFanTokenRequest{Denom: denom})
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+
+	res, err = app.FanTokenKeeper.FanToken(sdk.WrapSDKContext(ctx), &fantokentypes.QueryFanTokenRequest{Denom: fakeDenom})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+
+	// the reserved symbol can be issued only by the authority or the minter of the verified fantoken
+	_, err = app.FanTokenKeeper.Issue(ctx, "Adele Fans", "adele", "", maxSupply, other, other, 0, nil, "")
+	require.ErrorIs(t, err, fantokentypes.ErrSymbolReserved)
+
+	_, err = app.FanTokenKeeper.Issue(ctx, "Adele Tour", "adele", "", maxSupply, issuer, issuer, 0, nil, "")
+	require.NoError(t, err)
+
+	// the verified symbols are exported in the genesis
+	genesis := fantoken.ExportGenesis(ctx, app.FanTokenKeeper)
+	require.Equal(t, []fantokentypes.VerifiedSymbol{{Symbol: "adele", Denom: denom}}, genesis.VerifiedSymbols)
+	require.NoError(t, genesis.Validate())
+}
+
+func TestUpdateParamsProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the proposal is routed by the gov module of the app
+	h := app.GovKeeper.Router().GetRoute(fantokentypes.RouterKey)
+
+	newParams := fantokentypes.NewParams(
+		[]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))},
+		[]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))},
+		nil,
+		250,
+		[]string{"channel-0"},
+	)
+	proposal := fantokentypes.NewUpdateParamsProposal("Test", "description", newParams)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, h(ctx, proposal))
+	require.Equal(t, newParams, app.FanTokenKeeper.GetParamSet(ctx))
+
+	// the invalid params are rejected and the current ones are kept
+	invalidParams := newParams
+	invalidParams.IssueFee = []sdk.Coin{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}
+	proposal = fantokentypes.NewUpdateParamsProposal("Test", "description", invalidParams)
+	require.Error(t, proposal.ValidateBasic())
+	require.Error(t, h(ctx, proposal))
+	require.Equal(t, newParams, app.FanTokenKeeper.GetParamSet(ctx))
+}
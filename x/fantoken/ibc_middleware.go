@@ -0,0 +1,159 @@
+package fantoken
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+var (
+	_ porttypes.ICS4Wrapper = ICS4Wrapper{}
+	_ porttypes.IBCModule   = IBCMiddleware{}
+)
+
+// ICS4Wrapper attaches the metadata of the fantokens to the memo of the
+// outgoing ICS-20 packets, it wraps the channel keeper used by the transfer
+// keeper
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewICS4Wrapper creates a new ICS4Wrapper
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, keeper keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      keeper,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface, adding the memo to the
+// packets transferring a fantoken issued on the chain on the channels opted in
+// by the MemoChannels param, since the counterparties with an older transfer
+// module reject the packets with a memo. The transfers of the paused fantokens
+// are rejected
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	if w.keeper.IsPaused(ctx, data.Denom) {
+		return sdkerrors.Wrapf(types.ErrFanTokenPaused, "the transfers of %s are paused", data.Denom)
+	}
+
+	if !w.keeper.IsMemoChannel(ctx, packet.GetSourceChannel()) {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	metadata, found := w.keeper.GetPacketMetadata(ctx, data.Denom)
+	if !found {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	timeoutHeight, ok := packet.GetTimeoutHeight().(clienttypes.Height)
+	if !ok {
+		return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	}
+
+	memo, err := json.Marshal(types.PacketMemo{FanToken: &metadata})
+	if err != nil {
+		return err
+	}
+
+	packetData := types.FungibleTokenPacketData{
+		Denom:    data.Denom,
+		Amount:   data.Amount,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     string(memo),
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, channeltypes.NewPacket(
+		packetData.GetBytes(), packet.GetSequence(),
+		packet.GetSourcePort(), packet.GetSourceChannel(),
+		packet.GetDestPort(), packet.GetDestChannel(),
+		timeoutHeight, packet.GetTimeoutTimestamp(),
+	))
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (w ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// IBCMiddleware removes the memo from the incoming ICS-20 packets, which the
+// transfer module cannot decode, and sets the metadata of the received
+// fantoken vouchers from it
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer module
+func NewIBCMiddleware(app porttypes.IBCModule, keeper keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	packet, data, ok := removeMemo(packet)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	// the metadata is set on the vouchers only, not on the tokens returning to
+	// the chain
+	memo, ok := data.GetMemo()
+	if ok && !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		path := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+		trace := transfertypes.ParseDenomTrace(path)
+		im.keeper.SetVoucherMetaData(ctx, trace.IBCDenom(), path, trace.BaseDenom, *memo.FanToken)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	packet, _, _ = removeMemo(packet)
+	return im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	packet, _, _ = removeMemo(packet)
+	return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// removeMemo returns the packet with its ICS-20 packet data without memo, and
+// the decoded packet data. It returns false when the packet has no memo
+func removeMemo(packet channeltypes.Packet) (channeltypes.Packet, types.FungibleTokenPacketData, bool) {
+	var data types.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil || len(data.Memo) == 0 {
+		return packet, data, false
+	}
+
+	packet.Data = transfertypes.NewFungibleTokenPacketData(data.Denom, data.Amount, data.Sender, data.Receiver).GetBytes()
+
+	return packet, data, true
+}
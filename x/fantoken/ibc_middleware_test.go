@@ -0,0 +1,258 @@
+package fantoken_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdksimapp "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	simapp "github.com/bitsongofficial/go-bitsong/app"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := simapp.NewBitsongApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5,
+		simapp.MakeEncodingConfig(), sdksimapp.EmptyAppOptions{},
+	)
+	return app, simapp.NewDefaultGenesisState()
+}
+
+type IBCMiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func (suite *IBCMiddlewareTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestIBCMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCMiddlewareTestSuite))
+}
+
+func getApp(chain *ibctesting.TestChain) *simapp.BitsongApp {
+	return chain.App.(*simapp.BitsongApp)
+}
+
+// issueFanToken issues on the chain a fantoken minted to the sender account
+func (suite *IBCMiddlewareTestSuite) issueFanToken(chain *ibctesting.TestChain, amount sdk.Int) string {
+	app := getApp(chain)
+	ctx := chain.GetContext()
+	sender := chain.SenderAccount.GetAddress()
+
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.IssueFee = nil
+	params.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", amount, sender, sender, 0, nil, "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.FanTokenKeeper.Mint(ctx, sender, sender, sdk.NewCoin(denom, amount), ""))
+
+	suite.coordinator.CommitBlock(chain)
+
+	return denom
+}
+
+// allowMemo opts in the channel of the endpoint to the packets with the memo
+func (suite *IBCMiddlewareTestSuite) allowMemo(endpoint *ibctesting.Endpoint) {
+	app := getApp(endpoint.Chain)
+	ctx := endpoint.Chain.GetContext()
+
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.MemoChannels = append(params.MemoChannels, endpoint.ChannelID)
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	suite.coordinator.CommitBlock(endpoint.Chain)
+}
+
+// transfer sends the coin from the sender account of the source endpoint to
+// the sender account of its counterparty, and relays the packet
+func (suite *IBCMiddlewareTestSuite) transfer(src *ibctesting.Endpoint, coin sdk.Coin) transfertypes.FungibleTokenPacketData {
+	msg := transfertypes.NewMsgTransfer(
+		src.ChannelConfig.PortID, src.ChannelID, coin,
+		src.Chain.SenderAccount.GetAddress().String(), src.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	res, err := src.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+	suite.Require().Equal(coin.Amount.String(), data.Amount)
+
+	return data
+}
+
+func (suite *IBCMiddlewareTestSuite) TestFanTokenMetadata() {
+	amount := sdk.NewInt(1000)
+	denom := suite.issueFanToken(suite.chainA, amount)
+	suite.allowMemo(suite.path.EndpointA)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	senderB := suite.chainB.SenderAccount.GetAddress()
+
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, denom,
+	))
+	voucher := trace.IBCDenom()
+
+	// the voucher gets the metadata of the fantoken
+	packetData := suite.transfer(suite.path.EndpointA, sdk.NewCoin(denom, sdk.NewInt(400)))
+	suite.Require().Equal(sdk.NewInt(400), getApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, voucher).Amount)
+	suite.Require().Equal(sdk.NewInt(600), getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, denom).Amount)
+	suite.Require().Equal(senderB.String(), packetData.Receiver)
+
+	metadata, found := getApp(suite.chainB).BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucher)
+	suite.Require().True(found)
+	suite.Require().Equal(voucher, metadata.Base)
+	suite.Require().Equal("fan club token", metadata.Name)
+	suite.Require().Equal("CLUB", metadata.Symbol)
+	suite.Require().Equal("club", metadata.Display)
+	suite.Require().Equal("ipfs://club", metadata.Description)
+	suite.Require().Equal([]string{trace.GetFullDenomPath()}, metadata.DenomUnits[0].Aliases)
+	suite.Require().Equal(uint32(fantokentypes.FanTokenDecimal), metadata.DenomUnits[1].Exponent)
+
+	// the vouchers return to the source chain without memo
+	suite.transfer(suite.path.EndpointB, sdk.NewCoin(voucher, sdk.NewInt(100)))
+	suite.Require().Equal(sdk.NewInt(300), getApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), senderB, voucher).Amount)
+	suite.Require().Equal(sdk.NewInt(700), getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, denom).Amount)
+
+	_, found = getApp(suite.chainA).BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, voucher),
+	).IBCDenom())
+	suite.Require().False(found)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestNoMetadata() {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	suite.transfer(suite.path.EndpointA, coin)
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, coin.Denom,
+	)).IBCDenom()
+	suite.Require().Equal(coin.Amount, getApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher).Amount)
+
+	_, found := getApp(suite.chainB).BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucher)
+	suite.Require().False(found)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestRemoveMemo() {
+	denom := suite.issueFanToken(suite.chainA, sdk.NewInt(100))
+	suite.allowMemo(suite.path.EndpointA)
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(denom, sdk.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the plain transfer module cannot decode the packet with the memo
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().Error(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+	var memoData fantokentypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &memoData))
+	memo, ok := memoData.GetMemo()
+	suite.Require().True(ok)
+	suite.Require().Equal(fantokentypes.Metadata{Name: "fan club token", Symbol: "club", URI: "ipfs://club"}, *memo.FanToken)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	ack := suite.chainB.GetAcknowledgement(packet)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()), ack)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestPlainTransfer() {
+	denom := suite.issueFanToken(suite.chainA, sdk.NewInt(100))
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(denom, sdk.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the channel is not opted in, so the packet has no memo
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+	var memoData fantokentypes.FungibleTokenPacketData
+	suite.Require().NoError(json.Unmarshal(packet.GetData(), &memoData))
+	suite.Require().Empty(memoData.Memo)
+
+	// a counterparty running the plain transfer module receives the packet
+	appB := getApp(suite.chainB)
+	ctx := suite.chainB.GetContext()
+	ack := transfer.NewIBCModule(appB.TransferKeeper).OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().True(ack.Success())
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, denom,
+	)).IBCDenom()
+	suite.Require().Equal(sdk.NewInt(100), appB.BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), voucher).Amount)
+	_, found := appB.BankKeeper.GetDenomMetaData(ctx, voucher)
+	suite.Require().False(found)
+}
+
+func (suite *IBCMiddlewareTestSuite) TestPausedTransfer() {
+	denom := suite.issueFanToken(suite.chainA, sdk.NewInt(100))
+
+	app := getApp(suite.chainA)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	suite.Require().NoError(app.FanTokenKeeper.Pause(suite.chainA.GetContext(), denom, sender))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	// the transfers of the paused fantoken are rejected
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(denom, sdk.NewInt(100)),
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	_, err := app.TransferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg)
+	suite.Require().ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	// once unpaused, the fantoken is transferred
+	suite.Require().NoError(app.FanTokenKeeper.Unpause(suite.chainA.GetContext(), denom, sender))
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.transfer(suite.path.EndpointA, sdk.NewCoin(denom, sdk.NewInt(100)))
+	suite.Require().True(app.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, denom).IsZero())
+}
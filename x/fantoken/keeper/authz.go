@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+var _ authz.MsgServer = AuthzMsgServer{}
+
+// AuthzMsgServer wraps the x/authz msg server, so that the executions granted
+// by a HolderAuthorization are rejected when the grantee does not hold the
+// fantoken threshold, whatever the transaction or the contract dispatching
+// them. The authorization itself cannot check it, since its Accept gets
+// neither the grantee nor the balances
+type AuthzMsgServer struct {
+	authz.MsgServer
+
+	azk authzkeeper.Keeper
+	ftk *Keeper
+}
+
+// NewAuthzMsgServer creates a new AuthzMsgServer, the fantoken keeper is a
+// pointer since it is created after the authz keeper
+func NewAuthzMsgServer(azk authzkeeper.Keeper, ftk *Keeper) AuthzMsgServer {
+	return AuthzMsgServer{
+		MsgServer: azk,
+		azk:       azk,
+		ftk:       ftk,
+	}
+}
+
+// Exec implements the authz MsgServer interface. The messages are dispatched
+// one at a time, the holding of the grantee being checked right before the
+// execution of each message
+func (s AuthzMsgServer) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	var results [][]byte
+	for _, m := range msgs {
+		if err := s.validateHolder(ctx, grantee, m); err != nil {
+			return nil, err
+		}
+
+		res, err := s.azk.DispatchActions(ctx, grantee, []sdk.Msg{m})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res...)
+	}
+	return &authz.MsgExecResponse{Results: results}, nil
+}
+
+// validateHolder returns an error if the message is granted by a
+// HolderAuthorization and the grantee does not hold the fantoken threshold.
+// The messages of the grantee itself need no grant
+func (s AuthzMsgServer) validateHolder(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error {
+	signers := msg.GetSigners()
+	if len(signers) != 1 || signers[0].Equals(grantee) {
+		return nil
+	}
+
+	authorization, _ := s.azk.GetCleanAuthorization(ctx, grantee, signers[0], sdk.MsgTypeURL(msg))
+	holderAuthorization, ok := authorization.(*types.HolderAuthorization)
+	if !ok {
+		return nil
+	}
+
+	if err := s.ftk.ValidateHolder(ctx, grantee, holderAuthorization.Denom, holderAuthorization.MinAmount); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
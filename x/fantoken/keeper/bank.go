@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+var _ bankkeeper.Keeper = BankKeeper{}
+
+// BankKeeper wraps the x/bank keeper of the bank msg server and of the modules
+// moving the coins on behalf of the accounts, so that the rules of the
+// fantokens are enforced on the sends that are actually executed, whatever
+// the message dispatching them: the transfers of the paused fantokens are
+// rejected, and the senders pay the royalties of the fantokens they send to
+// other accounts
+type BankKeeper struct {
+	bankkeeper.Keeper
+
+	ftk *Keeper
+}
+
+// NewBankKeeper creates a new BankKeeper, the fantoken keeper is a pointer
+// since it is created after the bank keeper
+func NewBankKeeper(bk bankkeeper.Keeper, ftk *Keeper) BankKeeper {
+	return BankKeeper{
+		Keeper: bk,
+		ftk:    ftk,
+	}
+}
+
+// SendCoins implements the bank keeper interface
+func (k BankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.validateNotPaused(ctx, amt); err != nil {
+		return err
+	}
+	if err := k.payRoyalties(ctx, fromAddr, []sdk.AccAddress{toAddr}, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins implements the bank keeper interface
+func (k BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	recipients := make([]sdk.AccAddress, 0, len(outputs))
+	for _, output := range outputs {
+		recipient, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	for _, input := range inputs {
+		if err := k.validateNotPaused(ctx, input.Coins); err != nil {
+			return err
+		}
+
+		sender, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.payRoyalties(ctx, sender, recipients, input.Coins); err != nil {
+			return err
+		}
+	}
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount implements the bank keeper interface
+func (k BankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.validateNotPaused(ctx, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule implements the bank keeper interface
+func (k BankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.validateNotPaused(ctx, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToModule implements the bank keeper interface
+func (k BankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if err := k.validateNotPaused(ctx, amt); err != nil {
+		return err
+	}
+	return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+func (k BankKeeper) validateNotPaused(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if k.ftk.IsPaused(ctx, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrFanTokenPaused, "the transfers of %s are paused", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// payRoyalties pays the royalties due by the sender, unless the sender or all
+// the recipients are module accounts. The royalties are paid in the context
+// of the send, so they are reverted with it when it fails
+func (k BankKeeper) payRoyalties(ctx sdk.Context, sender sdk.AccAddress, recipients []sdk.AccAddress, coins sdk.Coins) error {
+	// skip the exemption checks when there is no royalty to pay
+	due := false
+	for _, coin := range coins {
+		if royalty, _ := k.ftk.GetRoyalty(ctx, coin); royalty.IsPositive() {
+			due = true
+			break
+		}
+	}
+	if !due || k.ftk.isModuleAccount(ctx, sender) {
+		return nil
+	}
+
+	for _, recipient := range recipients {
+		if !k.ftk.isModuleAccount(ctx, recipient) {
+			return k.ftk.PayRoyalties(ctx, sender, coins)
+		}
+	}
+	return nil
+}
+
+// isModuleAccount returns true if the address is a module account, including
+// the module accounts of the app not created yet
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+func (suite *KeeperTestSuite) TestBankKeeperPause() {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(100)), ""))
+	suite.NoError(suite.keeper.Pause(suite.ctx, denom, owner))
+
+	bk := suite.app.FanTokenBankKeeper
+	msgServer := bankkeeper.NewMsgServerImpl(bk)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))
+
+	// the sends of the paused fantoken are rejected
+	_, err = msgServer.Send(ctx, banktypes.NewMsgSend(owner, recipient, coins))
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	_, err = msgServer.MultiSend(ctx, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(owner, coins)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(recipient, coins)},
+	})
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	// the modules moving the coins on behalf of the accounts are rejected too
+	err = bk.SendCoinsFromAccountToModule(suite.ctx, owner, authtypes.FeeCollectorName, coins)
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	suite.Equal(sdk.NewInt(100), suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+	suite.True(suite.bk.GetBalance(suite.ctx, recipient, denom).IsZero())
+
+	// the other coins are not affected
+	_, err = msgServer.Send(ctx, banktypes.NewMsgSend(owner, recipient, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))))
+	suite.NoError(err)
+
+	// once unpaused, the fantoken can be sent again
+	suite.NoError(suite.keeper.Unpause(suite.ctx, denom, owner))
+	_, err = msgServer.Send(ctx, banktypes.NewMsgSend(owner, recipient, coins))
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(10), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+}
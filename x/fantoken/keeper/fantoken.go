@@ -62,15 +62,110 @@ func (k Keeper) AddFanToken(ctx sdk.Context, token *types.FanToken) error {
 	// set token
 	k.setFanToken(ctx, token)
 
+	// register the denom metadata into x/bank
+	k.setDenomMetaData(ctx, token)
+
 	if len(token.MetaData.Authority) != 0 {
 		// set token to be prefixed with metadata authority
 		k.setWithMetadataAuthority(ctx, token.GetAuthority(), token.GetDenom())
 	}
 
+	k.setIndexes(ctx, token)
+
 	return nil
 }
 
+// setIndexes indexes the fantoken by symbol and, until the minting is disabled, by minter
+func (k Keeper) setIndexes(ctx sdk.Context, token *types.FanToken) {
+	k.setSymbolIndex(ctx, token.GetSymbol(), token.GetDenom())
+
+	if len(token.Minter) != 0 {
+		k.setMinterIndex(ctx, token.GetMinter(), token.GetDenom())
+	}
+}
+
+// GetFanTokensBySymbol returns the fantokens with the specified symbol
+func (k Keeper) GetFanTokensBySymbol(ctx sdk.Context, symbol string) (fantokens []types.FanToken) {
+	return k.getFanTokensByIndex(ctx, types.KeyFanTokensBySymbol(symbol))
+}
+
+// GetFanTokensByMinter returns the fantokens of the specified minter
+func (k Keeper) GetFanTokensByMinter(ctx sdk.Context, minter sdk.AccAddress) (fantokens []types.FanToken) {
+	return k.getFanTokensByIndex(ctx, types.KeyFanTokensByMinter(minter))
+}
+
+// getFanTokensByIndex returns the fantokens whose denom follows the specified index prefix,
+// logging the indexed denoms without fantoken
+func (k Keeper) getFanTokensByIndex(ctx sdk.Context, prefix []byte) (fantokens []types.FanToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		denom := string(it.Key()[len(prefix):])
+		fantoken, err := k.getFanTokenByDenom(ctx, denom)
+		if err != nil {
+			// a dangling index is reported by the indexes invariant
+			k.Logger(ctx).Error("fantoken index without fantoken", "denom", denom, "err", err)
+			continue
+		}
+		fantokens = append(fantokens, fantoken)
+	}
+	return
+}
+
 // getFanTokenSupply queries the fantoken supply from the total supply
 func (k Keeper) getFanTokenSupply(ctx sdk.Context, denom string) sdk.Int {
 	return k.bankKeeper.GetSupply(ctx, denom).Amount
 }
+
+// SetDenomsMetaData registers the x/bank denom metadata of every existing fantoken
+func (k Keeper) SetDenomsMetaData(ctx sdk.Context) {
+	for _, fantoken := range k.GetFanTokens(ctx, nil) {
+		k.setDenomMetaData(ctx, &fantoken)
+	}
+}
+
+// IsVerified returns true if the symbol of the fantoken is reserved to it
+func (k Keeper) IsVerified(ctx sdk.Context, fantoken types.FanToken) bool {
+	denom, found := k.GetVerifiedDenom(ctx, fantoken.GetSymbol())
+	return found && denom == fantoken.GetDenom()
+}
+
+// RegisterVerifiedSymbol reserves the symbol to the fantoken with the specified denom, which must have that symbol.
+// A symbol already reserved is moved to the new fantoken.
+func (k Keeper) RegisterVerifiedSymbol(ctx sdk.Context, symbol, denom string) error {
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if fantoken.GetSymbol() != symbol {
+		return sdkerrors.Wrapf(types.ErrInvalidSymbol, "the symbol of the fantoken %s is %s", denom, fantoken.GetSymbol())
+	}
+
+	k.setVerifiedSymbol(ctx, symbol, denom)
+
+	return nil
+}
+
+// checkReservedSymbol returns an error if the symbol is reserved to a verified fantoken
+// whose authority or minter is not the issuer
+func (k Keeper) checkReservedSymbol(ctx sdk.Context, symbol string, issuer sdk.AccAddress) error {
+	denom, found := k.GetVerifiedDenom(ctx, symbol)
+	if !found {
+		return nil
+	}
+
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if issuer.String() == fantoken.MetaData.Authority || issuer.String() == fantoken.Minter {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrSymbolReserved, "the symbol %s is reserved to the fantoken %s", symbol, denom)
+}
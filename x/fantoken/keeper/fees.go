@@ -2,44 +2,37 @@
 package keeper
 
 import (
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// deductIssueFee performs fee handling for issuing token
-func (k Keeper) deductIssueFee(ctx sdk.Context, authority sdk.AccAddress) error {
-	params := k.GetParamSet(ctx)
-
-	// check if amount is zero
-	if params.IssueFee.Amount.IsZero() || params.IssueFee.Amount.IsNegative() {
-		return nil
-	}
-
-	// send issue fantoken fee to community pool
-	return k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{params.IssueFee}, authority)
+// deductIssueFee performs fee handling for issuing token, in the accepted fee of the given denom
+func (k Keeper) deductIssueFee(ctx sdk.Context, authority sdk.AccAddress, feeDenom string) error {
+	return k.deductFee(ctx, types.TypeMsgIssue, authority, k.GetParamSet(ctx).IssueFee, feeDenom)
 }
 
-// deductMintFee performs fee handling for minting token
-func (k Keeper) deductMintFee(ctx sdk.Context, authority sdk.AccAddress) error {
-	params := k.GetParamSet(ctx)
-
-	// check if amount is zero
-	if params.MintFee.Amount.IsZero() || params.MintFee.Amount.IsNegative() {
-		return nil
-	}
+// deductMintFee performs fee handling for minting token, in the accepted fee of the given denom
+func (k Keeper) deductMintFee(ctx sdk.Context, authority sdk.AccAddress, feeDenom string) error {
+	return k.deductFee(ctx, types.TypeMsgMint, authority, k.GetParamSet(ctx).MintFee, feeDenom)
+}
 
-	// send Mint fantoken fee to community pool
-	return k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{params.MintFee}, authority)
+// deductBurnFee performs fee handling for burning token, in the accepted fee of the given denom
+func (k Keeper) deductBurnFee(ctx sdk.Context, authority sdk.AccAddress, feeDenom string) error {
+	return k.deductFee(ctx, types.TypeMsgBurn, authority, k.GetParamSet(ctx).BurnFee, feeDenom)
 }
 
-// deductBurnFee performs fee handling for burning token
-func (k Keeper) deductBurnFee(ctx sdk.Context, authority sdk.AccAddress) error {
-	params := k.GetParamSet(ctx)
+// deductFee selects the fee to pay among the accepted ones and distributes it according to the fee split
+func (k Keeper) deductFee(ctx sdk.Context, action string, payer sdk.AccAddress, acceptedFees []sdk.Coin, feeDenom string) error {
+	fee, err := feestypes.SelectFee(acceptedFees, feeDenom)
+	if err != nil {
+		return err
+	}
 
 	// check if amount is zero
-	if params.BurnFee.Amount.IsZero() || params.BurnFee.Amount.IsNegative() {
+	if fee.IsZero() {
 		return nil
 	}
 
-	// send Burn fantoken fee to community pool
-	return k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{params.BurnFee}, authority)
+	return k.feesKeeper.DistributeFees(ctx, types.ModuleName, action, payer, fee)
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	gogotypes "github.com/gogo/protobuf/types"
@@ -35,7 +36,7 @@ func (k Keeper) FanToken(c context.Context, req *types.QueryFanTokenRequest) (*t
 		return nil, status.Errorf(codes.NotFound, "fan token %s not found", req.Denom)
 	}
 
-	return &types.QueryFanTokenResponse{Fantoken: fantoken}, nil
+	return &types.QueryFanTokenResponse{Fantoken: fantoken, Verified: k.IsVerified(ctx, *fantoken)}, nil
 }
 
 func (k Keeper) FanTokens(c context.Context, req *types.QueryFanTokensRequest) (*types.QueryFanTokensResponse, error) {
@@ -99,6 +100,133 @@ func (k Keeper) FanTokens(c context.Context, req *types.QueryFanTokensRequest) (
 	return &types.QueryFanTokensResponse{Fantokens: result, Pagination: pageRes}, nil
 }
 
+func (k Keeper) FanTokensBySymbol(c context.Context, req *types.QueryFanTokensBySymbolRequest) (*types.QueryFanTokensBySymbolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid symbol: %v", err)
+	}
+
+	fantokens, pageRes, err := k.paginateFanTokensByIndex(ctx, types.KeyFanTokensBySymbol(req.Symbol), req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFanTokensBySymbolResponse{Fantokens: fantokens, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FanTokensByMinter(c context.Context, req *types.QueryFanTokensByMinterRequest) (*types.QueryFanTokensByMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid minter address (%s)", err))
+	}
+
+	fantokens, pageRes, err := k.paginateFanTokensByIndex(ctx, types.KeyFanTokensByMinter(minter), req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFanTokensByMinterResponse{Fantokens: fantokens, Pagination: pageRes}, nil
+}
+
+// paginateFanTokensByIndex paginates the fantokens whose denom follows the specified index prefix
+func (k Keeper) paginateFanTokensByIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.FanToken, *query.PageResponse, error) {
+	var fantokens []types.FanToken
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, _ []byte) error {
+		fantoken, err := k.getFanTokenByDenom(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		fantokens = append(fantokens, fantoken)
+		return nil
+	})
+
+	return fantokens, pageRes, err
+}
+
+func (k Keeper) Paused(c context.Context, req *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	if !k.HasFanToken(ctx, req.Denom) {
+		return nil, status.Errorf(codes.NotFound, "fan token %s not found", req.Denom)
+	}
+
+	return &types.QueryPausedResponse{Paused: k.IsPaused(ctx, req.Denom)}, nil
+}
+
+func (k Keeper) MintSchedule(c context.Context, req *types.QueryMintScheduleRequest) (*types.QueryMintScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	schedule, err := k.GetMintSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "mint schedule %d not found", req.Id)
+	}
+
+	return &types.QueryMintScheduleResponse{
+		MintSchedule: schedule,
+		Released:     sdk.NewCoin(schedule.Denom, schedule.ReleasedAmount),
+		Pending:      sdk.NewCoin(schedule.Denom, schedule.PendingAmount()),
+	}, nil
+}
+
+func (k Keeper) MintSchedules(c context.Context, req *types.QueryMintSchedulesRequest) (*types.QueryMintSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	var schedules []types.MintSchedule
+
+	store := ctx.KVStore(k.storeKey)
+	scheduleStore := prefix.NewStore(store, types.KeyMintSchedulesByDenom(req.Denom))
+
+	pageRes, err := query.Paginate(scheduleStore, req.Pagination, func(key []byte, _ []byte) error {
+		schedule, err := k.GetMintSchedule(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryMintSchedulesResponse{MintSchedules: schedules, Pagination: pageRes}, nil
+}
+
 // Params return the all the parameter in fantoken module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -107,3 +235,95 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (k Keeper) CheckHolder(c context.Context, req *types.QueryCheckHolderRequest) (*types.QueryCheckHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address (%s)", err)
+	}
+
+	if err := k.validateHolderRequest(ctx, req.Denom, req.MinAmount, req.AtHeight); err != nil {
+		return nil, err
+	}
+
+	holder, balance := k.IsHolder(ctx, addr, req.Denom, req.MinAmount)
+
+	return &types.QueryCheckHolderResponse{Holder: holder, Balance: balance, Height: ctx.BlockHeight()}, nil
+}
+
+func (k Keeper) CheckHolders(c context.Context, req *types.QueryCheckHoldersRequest) (*types.QueryCheckHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Addresses) == 0 || len(req.Addresses) > types.MaxCheckHolders {
+		return nil, status.Errorf(codes.InvalidArgument, "the number of addresses must be between 1 and %d", types.MaxCheckHolders)
+	}
+
+	if err := k.validateHolderRequest(ctx, req.Denom, req.MinAmount, req.AtHeight); err != nil {
+		return nil, err
+	}
+
+	holders := make([]types.HolderStatus, len(req.Addresses))
+	for i, address := range req.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s (%s)", address, err)
+		}
+
+		holder, balance := k.IsHolder(ctx, addr, req.Denom, req.MinAmount)
+		holders[i] = types.HolderStatus{Address: address, Holder: holder, Balance: balance}
+	}
+
+	return &types.QueryCheckHoldersResponse{Holders: holders, Height: ctx.BlockHeight()}, nil
+}
+
+func (k Keeper) Holders(c context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := k.GetFanToken(ctx, req.Denom); err != nil {
+		return nil, status.Errorf(codes.NotFound, "fan token %s not found", req.Denom)
+	}
+
+	holders, pageRes, err := k.GetHolders(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryHoldersResponse{Holders: holders, Pagination: pageRes, Height: ctx.BlockHeight()}, nil
+}
+
+// validateHolderRequest checks the fantoken and the min amount of a holder
+// check, and that the state is queried at the requested height, if any. The
+// height is selected by the x-cosmos-block-height header of the query
+func (k Keeper) validateHolderRequest(ctx sdk.Context, denom string, minAmount sdk.Int, atHeight int64) error {
+	if err := types.ValidateHolderThreshold(denom, minAmount); err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if !k.HasFanToken(ctx, denom) {
+		return status.Errorf(codes.NotFound, "fan token %s not found", denom)
+	}
+
+	if atHeight < 0 || (atHeight > 0 && atHeight != ctx.BlockHeight()) {
+		return status.Errorf(codes.InvalidArgument, "the state is at height %d, query the height %d through the %s header", ctx.BlockHeight(), atHeight, grpctypes.GRPCBlockHeightHeader)
+	}
+
+	return nil
+}
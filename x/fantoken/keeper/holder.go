@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// IsHolder returns whether the address holds at least the min amount of the
+// fantoken, and its balance
+func (k Keeper) IsHolder(ctx sdk.Context, addr sdk.AccAddress, denom string, minAmount sdk.Int) (bool, sdk.Coin) {
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	return balance.Amount.GTE(minAmount), balance
+}
+
+// ValidateHolder returns an error if the address does not hold at least the
+// min amount of the fantoken
+func (k Keeper) ValidateHolder(ctx sdk.Context, addr sdk.AccAddress, denom string, minAmount sdk.Int) error {
+	if holder, balance := k.IsHolder(ctx, addr, denom, minAmount); !holder {
+		return sdkerrors.Wrapf(types.ErrNotHolder, "the address %s holds %s, less than %s%s", addr, balance, minAmount, denom)
+	}
+	return nil
+}
+
+// GetHolders returns a page of the addresses holding the fantoken, with their
+// balances. The x/bank balances are not indexed by denom, so each page
+// iterates the balances of all the denoms in the store order, from the first
+// one up to the end of the page, the page key being the length prefixed
+// address of the next holder. The cost of a page grows with the number of
+// balances of the chain, and counting the total iterates all of them, so
+// unlike the other paginated queries the total is only counted on request
+func (k Keeper) GetHolders(ctx sdk.Context, denom string, pageReq *query.PageRequest) ([]types.Holder, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		return nil, nil, fmt.Errorf("invalid request, reverse pagination is not supported")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	var (
+		holders []types.Holder
+		nextKey []byte
+		count   uint64
+	)
+
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if balance.Denom != denom || !balance.IsPositive() {
+			return false
+		}
+
+		key := address.MustLengthPrefix(addr)
+		if len(pageReq.Key) > 0 && bytes.Compare(key, pageReq.Key) < 0 {
+			return false
+		}
+
+		count++
+		if count <= pageReq.Offset {
+			return false
+		}
+
+		if uint64(len(holders)) == limit {
+			if nextKey == nil {
+				nextKey = key
+			}
+			return !countTotal
+		}
+
+		holders = append(holders, types.Holder{Address: addr.String(), Balance: balance})
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return holders, pageRes, nil
+}
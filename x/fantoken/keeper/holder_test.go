@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckHolder() {
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("holder")))
+	notHolder := sdk.AccAddress(tmhash.SumTruncated([]byte("notHolder")))
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(denom, sdk.NewInt(100)), ""))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// holds at least the min amount
+	res, err := suite.keeper.CheckHolder(ctx, &fantokentypes.QueryCheckHolderRequest{
+		Address: holder.String(), Denom: denom, MinAmount: sdk.NewInt(100),
+	})
+	suite.NoError(err)
+	suite.True(res.Holder)
+	suite.Equal(sdk.NewCoin(denom, sdk.NewInt(100)), res.Balance)
+	suite.Equal(suite.ctx.BlockHeight(), res.Height)
+
+	res, err = suite.keeper.CheckHolder(ctx, &fantokentypes.QueryCheckHolderRequest{
+		Address: holder.String(), Denom: denom, MinAmount: sdk.NewInt(101), AtHeight: suite.ctx.BlockHeight(),
+	})
+	suite.NoError(err)
+	suite.False(res.Holder)
+
+	// the min amount must be positive, and the denom a fantoken
+	_, err = suite.keeper.CheckHolder(ctx, &fantokentypes.QueryCheckHolderRequest{
+		Address: holder.String(), Denom: denom, MinAmount: sdk.ZeroInt(),
+	})
+	suite.Error(err)
+	_, err = suite.keeper.CheckHolder(ctx, &fantokentypes.QueryCheckHolderRequest{
+		Address: holder.String(), Denom: sdk.DefaultBondDenom, MinAmount: sdk.NewInt(1),
+	})
+	suite.Error(err)
+
+	// the state must be at the requested height
+	_, err = suite.keeper.CheckHolder(ctx, &fantokentypes.QueryCheckHolderRequest{
+		Address: holder.String(), Denom: denom, MinAmount: sdk.NewInt(1), AtHeight: suite.ctx.BlockHeight() + 1,
+	})
+	suite.Error(err)
+
+	// batch check
+	batch, err := suite.keeper.CheckHolders(ctx, &fantokentypes.QueryCheckHoldersRequest{
+		Addresses: []string{holder.String(), notHolder.String()}, Denom: denom, MinAmount: sdk.NewInt(50),
+	})
+	suite.NoError(err)
+	suite.Equal([]fantokentypes.HolderStatus{
+		{Address: holder.String(), Holder: true, Balance: sdk.NewCoin(denom, sdk.NewInt(100))},
+		{Address: notHolder.String(), Holder: false, Balance: sdk.NewCoin(denom, sdk.ZeroInt())},
+	}, batch.Holders)
+
+	addresses := make([]string, fantokentypes.MaxCheckHolders+1)
+	for i := range addresses {
+		addresses[i] = holder.String()
+	}
+	_, err = suite.keeper.CheckHolders(ctx, &fantokentypes.QueryCheckHoldersRequest{
+		Addresses: addresses, Denom: denom, MinAmount: sdk.NewInt(50),
+	})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestHolders() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	for i, holder := range []string{"holder1", "holder2", "holder3"} {
+		addr := sdk.AccAddress(tmhash.SumTruncated([]byte(holder)))
+		suite.NoError(suite.keeper.Mint(suite.ctx, owner, addr, sdk.NewCoin(denom, sdk.NewInt(int64(i+1)*100)), ""))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{
+		Denom: denom, Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(res.Holders, 3)
+	suite.Equal(uint64(3), res.Pagination.Total)
+	suite.Nil(res.Pagination.NextKey)
+	suite.Equal(suite.ctx.BlockHeight(), res.Height)
+
+	total := sdk.ZeroInt()
+	for _, holder := range res.Holders {
+		suite.Equal(denom, holder.Balance.Denom)
+		total = total.Add(holder.Balance.Amount)
+	}
+	suite.Equal(sdk.NewInt(600), total)
+
+	// the total is only counted on request
+	res, err = suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{Denom: denom})
+	suite.NoError(err)
+	suite.Len(res.Holders, 3)
+	suite.Zero(res.Pagination.Total)
+
+	// paginated by key and by offset
+	page, err := suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{
+		Denom: denom, Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Equal(res.Holders[:2], page.Holders)
+	suite.Equal(uint64(3), page.Pagination.Total)
+	suite.NotNil(page.Pagination.NextKey)
+
+	page, err = suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{
+		Denom: denom, Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2},
+	})
+	suite.NoError(err)
+	suite.Equal(res.Holders[2:], page.Holders)
+	suite.Nil(page.Pagination.NextKey)
+
+	page, err = suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{
+		Denom: denom, Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Equal(res.Holders[1:2], page.Holders)
+
+	// the fantoken must exist
+	_, err = suite.keeper.Holders(ctx, &fantokentypes.QueryHoldersRequest{Denom: "ftunknown"})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestHolderAuthorization() {
+	granter := owner
+	grantee := sdk.AccAddress(tmhash.SumTruncated([]byte("grantee")))
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	authorization := fantokentypes.NewHolderAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), denom, sdk.NewInt(50))
+	suite.NoError(authorization.ValidateBasic())
+	suite.Error(fantokentypes.NewHolderAuthorization("", denom, sdk.NewInt(50)).ValidateBasic())
+	suite.Error(fantokentypes.NewHolderAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), denom, sdk.ZeroInt()).ValidateBasic())
+
+	suite.NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, suite.ctx.BlockTime().Add(time.Hour)))
+
+	send := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))))
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{send})
+
+	msgServer := keeper.NewAuthzMsgServer(suite.app.AuthzKeeper, &suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// the grantee does not hold the fantoken
+	_, err = msgServer.Exec(ctx, &exec)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.True(suite.bk.GetBalance(suite.ctx, recipient, sdk.DefaultBondDenom).IsZero())
+
+	// the grantee holds the threshold
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, grantee, sdk.NewCoin(denom, sdk.NewInt(50)), ""))
+	res, err := msgServer.Exec(ctx, &exec)
+	suite.NoError(err)
+	suite.Len(res.Results, 1)
+	suite.Equal(sdk.NewInt(10), suite.bk.GetBalance(suite.ctx, recipient, sdk.DefaultBondDenom).Amount)
+
+	// the nested executions are checked as well
+	nestedExec := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+	suite.NoError(suite.bk.SendCoins(suite.ctx, grantee, owner, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1)))))
+	_, err = msgServer.Exec(ctx, &nestedExec)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the messages of the grantee itself are not checked
+	selfExec := authz.NewMsgExec(granter, []sdk.Msg{send})
+	_, err = msgServer.Exec(ctx, &selfExec)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(20), suite.bk.GetBalance(suite.ctx, recipient, sdk.DefaultBondDenom).Amount)
+}
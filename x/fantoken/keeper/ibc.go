@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPacketMetadata returns the metadata of the fantoken attached to its
+// outgoing ICS-20 packets, without the authority which is meaningless on the
+// counterparty chain
+func (k Keeper) GetPacketMetadata(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return types.Metadata{}, false
+	}
+
+	metadata := fantoken.GetMetaData()
+	metadata.Authority = ""
+
+	return metadata, true
+}
+
+// SetVoucherMetaData sets the x/bank denom metadata of the IBC voucher of a
+// fantoken, received with the specified metadata. The metadata of a voucher
+// is never overwritten, and it is ignored when the base denom of the voucher
+// is not a fantoken denom
+func (k Keeper) SetVoucherMetaData(ctx sdk.Context, voucher, path, baseDenom string, metadata types.Metadata) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, voucher); found {
+		return
+	}
+
+	metadata.Authority = ""
+	if err := types.ValidateDenom(baseDenom); err != nil {
+		return
+	}
+	if err := metadata.Validate(); err != nil {
+		return
+	}
+
+	fantoken := types.FanToken{Denom: voucher, MetaData: metadata}
+	bankMetadata := fantoken.GetBankMetadata()
+	bankMetadata.DenomUnits[0].Aliases = []string{path}
+	if err := bankMetadata.Validate(); err != nil {
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, bankMetadata)
+	k.Logger(ctx).Debug("set the metadata of the fantoken voucher", "voucher", voucher, "path", path)
+}
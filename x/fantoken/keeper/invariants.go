@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"sort"
+)
+
+// RegisterInvariants registers the fantoken module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "authority-index", AuthorityIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "symbol-minter-index", SymbolMinterIndexInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the fantoken module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := MaxSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = AuthorityIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return SymbolMinterIndexInvariant(k)(ctx)
+	}
+}
+
+// MaxSupplyInvariant checks that the bank supply of every fantoken does not exceed its max supply
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, fantoken := range k.GetFanTokens(ctx, nil) {
+			supply := k.getFanTokenSupply(ctx, fantoken.GetDenom())
+			if supply.GT(fantoken.GetMaxSupply()) {
+				broken++
+				msg += fmt.Sprintf("\t%s supply %s exceeds the max supply %s\n", fantoken.GetDenom(), supply, fantoken.GetMaxSupply())
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "max-supply",
+			fmt.Sprintf("%d fantokens exceeding the max supply found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// AuthorityIndexInvariant checks that the fantokens indexed by authority match the authority of their metadata
+func AuthorityIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		// the keys are not length prefixed, they are compared with the key built from the metadata authority
+		indexed := make(map[string][]byte)
+		store := ctx.KVStore(k.storeKey)
+		it := sdk.KVStorePrefixIterator(store, types.PrefixFanTokens)
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			var denom gogotypes.StringValue
+			k.cdc.MustUnmarshal(it.Value(), &denom)
+
+			if _, ok := indexed[denom.Value]; ok {
+				broken++
+				msg += fmt.Sprintf("\t%s is indexed by more than one authority\n", denom.Value)
+				continue
+			}
+			indexed[denom.Value] = it.Key()
+		}
+
+		for _, fantoken := range k.GetFanTokens(ctx, nil) {
+			denom := fantoken.GetDenom()
+			key, ok := indexed[denom]
+			delete(indexed, denom)
+
+			if fantoken.MetaData.Authority == "" {
+				if ok {
+					broken++
+					msg += fmt.Sprintf("\t%s without authority is indexed by authority\n", denom)
+				}
+				continue
+			}
+
+			if !ok || !bytes.Equal(key, types.KeyFanTokens(fantoken.GetAuthority(), denom)) {
+				broken++
+				msg += fmt.Sprintf("\t%s is not indexed by its authority %s\n", denom, fantoken.MetaData.Authority)
+			}
+		}
+
+		var orphans []string
+		for denom := range indexed {
+			orphans = append(orphans, denom)
+		}
+		sort.Strings(orphans)
+
+		for _, denom := range orphans {
+			broken++
+			msg += fmt.Sprintf("\t%s is indexed by authority but does not exist\n", denom)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "authority-index",
+			fmt.Sprintf("%d fantokens with an invalid authority index found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// SymbolMinterIndexInvariant checks that every fantoken is indexed by its symbol and by its minter,
+// and that the indexes do not reference other fantokens
+func SymbolMinterIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		// the indexed keys are compared with the keys built from the fantokens
+		indexed := make(map[string]bool)
+		store := ctx.KVStore(k.storeKey)
+		for _, prefix := range [][]byte{types.PrefixFanTokenBySymbol, types.PrefixFanTokenByMinter} {
+			it := sdk.KVStorePrefixIterator(store, prefix)
+			for ; it.Valid(); it.Next() {
+				indexed[string(it.Key())] = true
+			}
+			it.Close()
+		}
+
+		for _, fantoken := range k.GetFanTokens(ctx, nil) {
+			denom := fantoken.GetDenom()
+
+			key := string(types.KeyFanTokenBySymbol(fantoken.GetSymbol(), denom))
+			if !indexed[key] {
+				broken++
+				msg += fmt.Sprintf("\t%s is not indexed by its symbol %s\n", denom, fantoken.GetSymbol())
+			}
+			delete(indexed, key)
+
+			if len(fantoken.Minter) == 0 {
+				continue
+			}
+
+			key = string(types.KeyFanTokenByMinter(fantoken.GetMinter(), denom))
+			if !indexed[key] {
+				broken++
+				msg += fmt.Sprintf("\t%s is not indexed by its minter %s\n", denom, fantoken.Minter)
+			}
+			delete(indexed, key)
+		}
+
+		var stale []string
+		for key := range indexed {
+			stale = append(stale, key)
+		}
+		sort.Strings(stale)
+
+		for _, key := range stale {
+			broken++
+			msg += fmt.Sprintf("\tthe index key %X does not match any fantoken\n", []byte(key))
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "symbol-minter-index",
+			fmt.Sprintf("%d invalid symbol and minter indexes found\n%s", broken, msg),
+		), broken != 0
+	}
+}
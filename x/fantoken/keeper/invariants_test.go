@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestMaxSupplyInvariant() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, maxSupply), ""))
+	_, broken := keeper.MaxSupplyInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// mint out of the fantoken keeper over the max supply
+	suite.NoError(suite.bk.MintCoins(suite.ctx, fantokentypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	_, broken = keeper.MaxSupplyInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestAuthorityIndexInvariant() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	_, broken := keeper.AuthorityIndexInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	newAuthority := sdk.AccAddress("newAuthority________")
+	suite.NoError(suite.keeper.SetAuthority(suite.ctx, denom, owner, newAuthority))
+	_, broken = keeper.AuthorityIndexInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// a stale index for the old authority
+	store := suite.ctx.KVStore(suite.app.GetKey(fantokentypes.StoreKey))
+	store.Set(fantokentypes.KeyFanTokens(owner, denom), store.Get(fantokentypes.KeyFanTokens(newAuthority, denom)))
+	_, broken = keeper.AuthorityIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// a missing index
+	store.Delete(fantokentypes.KeyFanTokens(owner, denom))
+	store.Delete(fantokentypes.KeyFanTokens(newAuthority, denom))
+	_, broken = keeper.AuthorityIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestSymbolMinterIndexInvariant() {
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	_, broken := keeper.SymbolMinterIndexInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// the minter index follows the minter
+	newMinter := sdk.AccAddress("newMinter___________")
+	suite.NoError(suite.keeper.SetMinter(suite.ctx, denom, owner, newMinter))
+	_, broken = keeper.SymbolMinterIndexInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// a stale index of a fantoken which does not exist
+	store := suite.ctx.KVStore(suite.app.GetKey(fantokentypes.StoreKey))
+	store.Set(fantokentypes.KeyFanTokenBySymbol(symbol, "ftunknown"), []byte{0x01})
+	_, broken = keeper.SymbolMinterIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// the stale index is skipped by the queries
+	suite.Len(suite.keeper.GetFanTokensBySymbol(suite.ctx, symbol), 1)
+
+	// a missing index
+	store.Delete(fantokentypes.KeyFanTokenBySymbol(symbol, "ftunknown"))
+	store.Delete(fantokentypes.KeyFanTokenByMinter(newMinter, denom))
+	_, broken = keeper.SymbolMinterIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
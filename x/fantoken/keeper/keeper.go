@@ -15,9 +15,12 @@ type Keeper struct {
 	cdc           codec.Codec
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
-	distrKeeper   types.DistrKeeper
+	feesKeeper    types.FeesKeeper
 	paramSpace    types.ParamSubspace
 	blockedAddrs  map[string]bool
+
+	// the address allowed to update the params, usually the gov module account
+	authority string
 }
 
 func NewKeeper(
@@ -26,8 +29,9 @@ func NewKeeper(
 	paramSpace types.ParamSubspace,
 	ak types.AccountKeeper,
 	bankKeeper types.BankKeeper,
-	distrKeeper types.DistrKeeper,
+	feesKeeper types.FeesKeeper,
 	blockedAddrs map[string]bool,
+	authority string,
 ) Keeper {
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the " + types.ModuleName + " module account has not been set")
@@ -39,22 +43,29 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:     key,
-		cdc:          cdc,
-		paramSpace:   paramSpace,
-		bankKeeper:   bankKeeper,
-		distrKeeper:  distrKeeper,
-		blockedAddrs: blockedAddrs,
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bankKeeper,
+		feesKeeper:    feesKeeper,
+		blockedAddrs:  blockedAddrs,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the fantoken params
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("go-bitsong/%s", types.ModuleName))
 }
 
 // Issue issues a new fantoken
-func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply sdk.Int, minter, authority sdk.AccAddress) (denom string, err error) {
+func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply sdk.Int, minter, authority sdk.AccAddress, royaltyBps uint32, royaltyAddress sdk.AccAddress, feeDenom string) (denom string, err error) {
 	if k.blockedAddrs[authority.String()] {
 		return denom, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", authority.String())
 	}
@@ -69,12 +80,33 @@ func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply sdk.I
 		return denom, sdkerrors.Wrapf(types.ErrInvalidMinter, "the address %s is not a valid minter address", minter)
 	}
 
+	// the royalties are sent with the plain bank send, which would break the
+	// balance accounting of a module account receiving them
+	if !royaltyAddress.Empty() && k.isModuleAccount(ctx, royaltyAddress) {
+		return denom, sdkerrors.Wrapf(types.ErrInvalidRoyalty, "the royalty address %s is a module account", royaltyAddress.String())
+	}
+
+	// check the royalty does not exceed the maximum allowed
+	if maxRoyaltyBps := k.GetParamSet(ctx).MaxRoyaltyBps; royaltyBps > maxRoyaltyBps {
+		return denom, sdkerrors.Wrapf(types.ErrInvalidRoyalty, "the royalty exceeds the maximum allowed; expected [0, %d], got %d", maxRoyaltyBps, royaltyBps)
+	}
+
+	// check the symbol is not reserved to another issuer
+	if err := k.checkReservedSymbol(ctx, symbol, authority); err != nil {
+		return denom, err
+	}
+
 	// handle issue fee
-	if err := k.deductIssueFee(ctx, minter); err != nil {
+	if err := k.deductIssueFee(ctx, minter, feeDenom); err != nil {
 		return denom, err
 	}
 
 	fantoken := types.NewFanToken(name, symbol, uri, maxSupply, minter, authority, ctx.BlockHeight())
+	fantoken.RoyaltyBps = royaltyBps
+	if !royaltyAddress.Empty() {
+		fantoken.RoyaltyAddress = royaltyAddress.String()
+	}
+
 	if err := fantoken.Validate(); err != nil {
 		return denom, err
 	}
@@ -92,7 +124,18 @@ func (k Keeper) Issue(ctx sdk.Context, name, symbol, uri string, maxSupply sdk.I
 }
 
 // Mint mints the specified amount of fantoken to the specified recipient
-func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error {
+func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin, feeDenom string) error {
+	return k.mint(ctx, minter, recipient, coin, true, feeDenom)
+}
+
+// MintWithoutFee mints the specified amount of fantoken to the specified
+// recipient without charging the mint fee to the minter, for the mints executed
+// by the modules on behalf of the minter
+func (k Keeper) MintWithoutFee(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error {
+	return k.mint(ctx, minter, recipient, coin, false, "")
+}
+
+func (k Keeper) mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin, chargeFee bool, feeDenom string) error {
 	if recipient.Empty() {
 		return sdkerrors.Wrapf(types.ErrInvalidRecipient, "the address %s is not a valid recipient", recipient.String())
 	}
@@ -122,13 +165,19 @@ func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk
 		return sdkerrors.Wrapf(types.ErrInvalidMinter, "the address %s is not the minter of the fantoken %s", minter.String(), coin.Denom)
 	}
 
+	if k.IsPaused(ctx, coin.Denom) {
+		return sdkerrors.Wrapf(types.ErrFanTokenPaused, "cannot mint the fantoken %s", coin.Denom)
+	}
+
 	// handle Mint fee
-	if err := k.deductMintFee(ctx, minter); err != nil {
-		return err
+	if chargeFee {
+		if err := k.deductMintFee(ctx, minter, feeDenom); err != nil {
+			return err
+		}
 	}
 
-	supply := k.getFanTokenSupply(ctx, fantoken.GetDenom())
-	mintableAmt := fantoken.MaxSupply.Sub(supply)
+	// the amount locked into the mint schedules cannot be minted
+	mintableAmt := k.getMintableAmount(ctx, fantoken)
 
 	if coin.Amount.GT(mintableAmt) {
 		return sdkerrors.Wrapf(
@@ -148,7 +197,7 @@ func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk
 }
 
 // Burn burns the specified amount of fantoken
-func (k Keeper) Burn(ctx sdk.Context, coin sdk.Coin, owner sdk.AccAddress) error {
+func (k Keeper) Burn(ctx sdk.Context, coin sdk.Coin, owner sdk.AccAddress, feeDenom string) error {
 	if k.blockedAddrs[owner.String()] {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", owner.String())
 	}
@@ -158,7 +207,7 @@ func (k Keeper) Burn(ctx sdk.Context, coin sdk.Coin, owner sdk.AccAddress) error
 	}
 
 	// handle Burn fee
-	if err := k.deductBurnFee(ctx, owner); err != nil {
+	if err := k.deductBurnFee(ctx, owner, feeDenom); err != nil {
 		return err
 	}
 
@@ -248,9 +297,10 @@ func (k Keeper) SetMinter(ctx sdk.Context, denom string, oldMinter, newMinter sd
 	fantoken.Minter = newMinter.String()
 
 	if newMinter.String() == "" {
-		// at this point we can set the official supply
+		// at this point we can set the official supply, which includes the amount
+		// still locked into the mint schedules
 		supply := k.getFanTokenSupply(ctx, fantoken.GetDenom())
-		fantoken.MaxSupply = supply
+		fantoken.MaxSupply = supply.Add(k.GetLockedAmount(ctx, fantoken.GetDenom()))
 	}
 
 	if err := fantoken.Validate(); err != nil {
@@ -260,6 +310,12 @@ func (k Keeper) SetMinter(ctx sdk.Context, denom string, oldMinter, newMinter sd
 	// update fantoken
 	k.setFanToken(ctx, &fantoken)
 
+	// move the minter index to the new minter
+	k.deleteMinterIndex(ctx, oldMinter, fantoken.GetDenom())
+	if !newMinter.Empty() {
+		k.setMinterIndex(ctx, newMinter, fantoken.GetDenom())
+	}
+
 	return nil
 }
 
@@ -291,5 +347,56 @@ func (k Keeper) SetUri(ctx sdk.Context, denom, newUri string, authority sdk.AccA
 	// update fantoken
 	k.setFanToken(ctx, &fantoken)
 
+	// update the denom metadata
+	k.setDenomMetaData(ctx, &fantoken)
+
+	return nil
+}
+
+// Pause pauses the transfers of the specified fantoken
+func (k Keeper) Pause(ctx sdk.Context, denom string, authority sdk.AccAddress) error {
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if authority.Empty() {
+		return types.ErrInvalidAuthority
+	}
+
+	if authority.String() != fantoken.MetaData.Authority {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "the address %s is not the authority of the fantoken %s", authority, denom)
+	}
+
+	if k.IsPaused(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrFanTokenPaused, "the fantoken %s is already paused", denom)
+	}
+
+	k.SetPaused(ctx, denom)
+
+	return nil
+}
+
+// Unpause resumes the transfers of the specified fantoken
+func (k Keeper) Unpause(ctx sdk.Context, denom string, authority sdk.AccAddress) error {
+	fantoken, err := k.getFanTokenByDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if authority.Empty() {
+		return types.ErrInvalidAuthority
+	}
+
+	if authority.String() != fantoken.MetaData.Authority {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "the address %s is not the authority of the fantoken %s", authority, denom)
+	}
+
+	if !k.IsPaused(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrFanTokenNotPaused, "the fantoken %s is not paused", denom)
+	}
+
+	k.deletePaused(ctx, denom)
+
 	return nil
 }
@@ -1,11 +1,17 @@
 package keeper_test
 
 import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	simapp "github.com/bitsongofficial/go-bitsong/app"
 	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
 	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
+	merkledroptypes "github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/stretchr/testify/suite"
@@ -61,7 +67,7 @@ func TestKeeperSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) TestIssue() {
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 	suite.True(suite.keeper.HasFanToken(suite.ctx, denom))
 
@@ -75,11 +81,61 @@ func (suite *KeeperTestSuite) TestIssue() {
 	suite.Equal(maxSupply, issuedToken.GetMaxSupply())
 	suite.Equal(owner, issuedToken.GetAuthority())
 	suite.Equal(owner, issuedToken.GetMinter())
+
+	// check the bank denom metadata
+	metadata, found := suite.bk.GetDenomMetaData(suite.ctx, denom)
+	suite.True(found)
+	suite.NoError(metadata.Validate())
+	suite.Equal(denom, metadata.Base)
+	suite.Equal(symbol, metadata.Display)
+	suite.Equal(name, metadata.Name)
+	suite.Equal("BTC", metadata.Symbol)
+	suite.Len(metadata.DenomUnits, 2)
+	suite.Equal(uint32(fantokentypes.FanTokenDecimal), metadata.DenomUnits[1].Exponent)
+}
+
+func (suite *KeeperTestSuite) TestIssueFeeDenom() {
+	bondFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	usdcFee := sdk.NewCoin("uusdc", sdk.NewInt(10))
+
+	params := fantokentypes.DefaultParams()
+	params.IssueFee = []sdk.Coin{bondFee, usdcFee}
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	usdc := sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(100)))
+	suite.NoError(suite.bk.MintCoins(suite.ctx, fantokentypes.ModuleName, usdc))
+	suite.NoError(suite.bk.SendCoinsFromModuleToAccount(suite.ctx, fantokentypes.ModuleName, owner, usdc))
+
+	// the first accepted fee is charged by default
+	_, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	suite.Equal(initAmt.Sub(bondFee.Amount), suite.bk.GetBalance(suite.ctx, owner, sdk.DefaultBondDenom).Amount)
+
+	// the payer can choose another accepted fee
+	_, err = suite.keeper.Issue(suite.ctx, name, "eth", uri, maxSupply, owner, owner, 0, nil, "uusdc")
+	suite.NoError(err)
+	suite.Equal(initAmt.Sub(bondFee.Amount), suite.bk.GetBalance(suite.ctx, owner, sdk.DefaultBondDenom).Amount)
+	suite.Equal(sdk.NewInt(90), suite.bk.GetBalance(suite.ctx, owner, "uusdc").Amount)
+
+	// a denom not accepted is rejected
+	_, err = suite.keeper.Issue(suite.ctx, name, "atom", uri, maxSupply, owner, owner, 0, nil, "uatom")
+	suite.ErrorIs(err, feestypes.ErrFeeDenomNotAccepted)
+}
+
+func (suite *KeeperTestSuite) TestIssueShortSymbolMetadata() {
+	denom, err := suite.keeper.Issue(suite.ctx, "", "a1", uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	metadata, found := suite.bk.GetDenomMetaData(suite.ctx, denom)
+	suite.True(found)
+	suite.NoError(metadata.Validate())
+	suite.Equal("fta1", metadata.Display)
+	suite.Equal("a1", metadata.Name)
 }
 
 func (suite *KeeperTestSuite) TestMint() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 
 	// check actual fantoken balance
@@ -93,7 +149,7 @@ func (suite *KeeperTestSuite) TestMint() {
 	suite.Equal(denom, supply.Denom)
 
 	// mint some token
-	suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)))
+	suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)), "")
 
 	// check the fantoken balance once a time
 	balance = suite.bk.GetBalance(suite.ctx, owner, denom)
@@ -108,14 +164,14 @@ func (suite *KeeperTestSuite) TestMint() {
 
 func (suite *KeeperTestSuite) TestBurn() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 
 	// mint some token
-	suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)))
+	suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)), "")
 
 	// burn some token
-	suite.keeper.Burn(suite.ctx, sdk.NewCoin(denom, sdk.NewInt(6)), owner)
+	suite.keeper.Burn(suite.ctx, sdk.NewCoin(denom, sdk.NewInt(6)), owner, "")
 
 	// check the fantoken balance
 	balance := suite.bk.GetBalance(suite.ctx, owner, denom)
@@ -130,7 +186,7 @@ func (suite *KeeperTestSuite) TestBurn() {
 
 func (suite *KeeperTestSuite) TestSetMinter() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 
 	// set the new minter
@@ -155,7 +211,7 @@ func (suite *KeeperTestSuite) TestSetMinter() {
 
 func (suite *KeeperTestSuite) TestSetAuthority() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 
 	// set the new authority
@@ -180,7 +236,7 @@ func (suite *KeeperTestSuite) TestSetAuthority() {
 
 func (suite *KeeperTestSuite) TestSetUri() {
 	// issue a new fantoken
-	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner)
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
 	suite.NoError(err)
 
 	newUri := "ipfs://newUri"
@@ -194,6 +250,11 @@ func (suite *KeeperTestSuite) TestSetUri() {
 	suite.NoError(err)
 	suite.Equal(newUri, fantoken.GetURI())
 
+	// the denom metadata should reflect the new uri
+	metadata, found := suite.bk.GetDenomMetaData(suite.ctx, denom)
+	suite.True(found)
+	suite.Equal(newUri, metadata.Description)
+
 	emptyUri := ""
 	// set the new uri
 	err = suite.keeper.SetUri(suite.ctx, denom, emptyUri, owner)
@@ -215,3 +276,226 @@ func (suite *KeeperTestSuite) TestSetUri() {
 	err = suite.keeper.SetUri(suite.ctx, denom, malformedUri, owner)
 	suite.Error(err)
 }
+
+func (suite *KeeperTestSuite) TestPause() {
+	// issue a new fantoken
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	suite.False(suite.keeper.IsPaused(suite.ctx, denom))
+
+	// only the authority can pause the fantoken
+	notAuthority := sdk.AccAddress(tmhash.SumTruncated([]byte("notAuthority")))
+	err = suite.keeper.Pause(suite.ctx, denom, notAuthority)
+	suite.ErrorIs(err, fantokentypes.ErrInvalidAuthority)
+
+	// pause the fantoken
+	err = suite.keeper.Pause(suite.ctx, denom, owner)
+	suite.NoError(err)
+	suite.True(suite.keeper.IsPaused(suite.ctx, denom))
+	suite.Equal([]string{denom}, suite.keeper.GetPausedDenoms(suite.ctx))
+
+	// a paused fantoken cannot be paused again
+	err = suite.keeper.Pause(suite.ctx, denom, owner)
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	// a paused fantoken cannot be minted
+	err = suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)), "")
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenPaused)
+
+	// only the authority can unpause the fantoken
+	err = suite.keeper.Unpause(suite.ctx, denom, notAuthority)
+	suite.ErrorIs(err, fantokentypes.ErrInvalidAuthority)
+
+	// unpause the fantoken
+	err = suite.keeper.Unpause(suite.ctx, denom, owner)
+	suite.NoError(err)
+	suite.False(suite.keeper.IsPaused(suite.ctx, denom))
+	suite.Empty(suite.keeper.GetPausedDenoms(suite.ctx))
+
+	// an unpaused fantoken cannot be unpaused again
+	err = suite.keeper.Unpause(suite.ctx, denom, owner)
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenNotPaused)
+
+	// the fantoken can be minted again
+	err = suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, sdk.NewInt(10)), "")
+	suite.NoError(err)
+
+	// an unknown fantoken cannot be paused
+	err = suite.keeper.Pause(suite.ctx, "ftunknown", owner)
+	suite.ErrorIs(err, fantokentypes.ErrFanTokenNotExists)
+}
+
+func (suite *KeeperTestSuite) TestFanTokensBySymbolAndMinter() {
+	minter := sdk.AccAddress(tmhash.SumTruncated([]byte("minter")))
+
+	// fantokens with the same symbol and different names have different denoms
+	denomA, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	denomB, err := suite.keeper.Issue(suite.ctx, "Bitcoin Cash", symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	denomC, err := suite.keeper.Issue(suite.ctx, "Ether", "eth", uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.keeper.FanTokensBySymbol(ctx, &fantokentypes.QueryFanTokensBySymbolRequest{Symbol: symbol})
+	suite.NoError(err)
+	suite.ElementsMatch([]string{denomA, denomB}, fantokenDenoms(res.Fantokens))
+
+	// paginate the fantokens by symbol
+	page, err := suite.keeper.FanTokensBySymbol(ctx, &fantokentypes.QueryFanTokensBySymbolRequest{
+		Symbol:     symbol,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(page.Fantokens, 1)
+	suite.Equal(uint64(2), page.Pagination.Total)
+
+	next, err := suite.keeper.FanTokensBySymbol(ctx, &fantokentypes.QueryFanTokensBySymbolRequest{
+		Symbol:     symbol,
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Len(next.Fantokens, 1)
+	suite.NotEqual(page.Fantokens[0].Denom, next.Fantokens[0].Denom)
+
+	// a symbol which is the prefix of another one does not match it
+	res, err = suite.keeper.FanTokensBySymbol(ctx, &fantokentypes.QueryFanTokensBySymbolRequest{Symbol: "et"})
+	suite.NoError(err)
+	suite.Empty(res.Fantokens)
+
+	_, err = suite.keeper.FanTokensBySymbol(ctx, &fantokentypes.QueryFanTokensBySymbolRequest{Symbol: "BTC"})
+	suite.Error(err)
+
+	byMinter, err := suite.keeper.FanTokensByMinter(ctx, &fantokentypes.QueryFanTokensByMinterRequest{Minter: owner.String()})
+	suite.NoError(err)
+	suite.ElementsMatch([]string{denomA, denomB, denomC}, fantokenDenoms(byMinter.Fantokens))
+
+	_, err = suite.keeper.FanTokensByMinter(ctx, &fantokentypes.QueryFanTokensByMinterRequest{Minter: "invalid"})
+	suite.Error(err)
+
+	// the minter index follows the minting ability transfer
+	suite.NoError(suite.keeper.SetMinter(suite.ctx, denomA, owner, minter))
+	suite.ElementsMatch([]string{denomB, denomC}, fantokenDenoms(suite.keeper.GetFanTokensByMinter(suite.ctx, owner)))
+	suite.ElementsMatch([]string{denomA}, fantokenDenoms(suite.keeper.GetFanTokensByMinter(suite.ctx, minter)))
+
+	// and it is deleted when the minting is disabled
+	suite.NoError(suite.keeper.SetMinter(suite.ctx, denomA, minter, sdk.AccAddress{}))
+	suite.Empty(suite.keeper.GetFanTokensByMinter(suite.ctx, minter))
+	suite.Len(suite.keeper.GetFanTokensBySymbol(suite.ctx, symbol), 2)
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate1to2() {
+	denomA, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	denomB, err := suite.keeper.Issue(suite.ctx, "Ether", "eth", uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	suite.NoError(suite.keeper.SetMinter(suite.ctx, denomB, owner, sdk.AccAddress{}))
+
+	// drop the indexes, as in the version 1 of the store
+	store := suite.ctx.KVStore(suite.app.GetKey(fantokentypes.StoreKey))
+	for _, prefix := range [][]byte{fantokentypes.PrefixFanTokenBySymbol, fantokentypes.PrefixFanTokenByMinter} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	suite.Empty(suite.keeper.GetFanTokensBySymbol(suite.ctx, symbol))
+	suite.Empty(suite.keeper.GetFanTokensByMinter(suite.ctx, owner))
+
+	suite.NoError(keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx))
+
+	suite.ElementsMatch([]string{denomA}, fantokenDenoms(suite.keeper.GetFanTokensBySymbol(suite.ctx, symbol)))
+	suite.ElementsMatch([]string{denomB}, fantokenDenoms(suite.keeper.GetFanTokensBySymbol(suite.ctx, "eth")))
+	suite.ElementsMatch([]string{denomA}, fantokenDenoms(suite.keeper.GetFanTokensByMinter(suite.ctx, owner)))
+}
+
+func (suite *KeeperTestSuite) TestRoyalties() {
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("holder")))
+	royaltyAddr := sdk.AccAddress(tmhash.SumTruncated([]byte("royalty")))
+
+	// the royalty cannot exceed the max royalty param
+	_, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, fantokentypes.DefaultParams().MaxRoyaltyBps+1, nil, "")
+	suite.ErrorIs(err, fantokentypes.ErrInvalidRoyalty)
+
+	// the royalties cannot be paid to a module account
+	for _, moduleName := range []string{merkledroptypes.ModuleName, wasmtypes.ModuleName} {
+		_, err = suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 500, authtypes.NewModuleAddress(moduleName), "")
+		suite.ErrorIs(err, fantokentypes.ErrInvalidRoyalty)
+	}
+
+	// a royalty of 5% paid to the authority
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 500, nil, "")
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(denom, sdk.NewInt(1000)), ""))
+
+	royalty, recipient := suite.keeper.GetRoyalty(suite.ctx, sdk.NewCoin(denom, sdk.NewInt(100)))
+	suite.Equal(sdk.NewCoin(denom, sdk.NewInt(5)), royalty)
+	suite.Equal(owner, recipient)
+
+	// the royalty is truncated
+	royalty, _ = suite.keeper.GetRoyalty(suite.ctx, sdk.NewCoin(denom, sdk.NewInt(19)))
+	suite.True(royalty.IsZero())
+
+	// no royalty on the non fantokens
+	royalty, _ = suite.keeper.GetRoyalty(suite.ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	suite.True(royalty.IsZero())
+
+	suite.NoError(suite.keeper.PayRoyalties(suite.ctx, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	suite.Equal(sdk.NewInt(995), suite.bk.GetBalance(suite.ctx, holder, denom).Amount)
+	suite.Equal(sdk.NewInt(5), suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+
+	// the holder cannot pay both the transfer and the royalty
+	err = suite.keeper.PayRoyalties(suite.ctx, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(990))))
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the authority does not pay royalties to itself
+	suite.NoError(suite.keeper.PayRoyalties(suite.ctx, owner, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(5)))))
+	suite.Equal(sdk.NewInt(5), suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+
+	// a royalty of 10% paid to a designated address
+	denom, err = suite.keeper.Issue(suite.ctx, "Ether", "eth", uri, maxSupply, owner, owner, 1000, royaltyAddr, "")
+	suite.NoError(err)
+	suite.NoError(suite.keeper.Mint(suite.ctx, owner, holder, sdk.NewCoin(denom, sdk.NewInt(1000)), ""))
+
+	suite.NoError(suite.keeper.PayRoyalties(suite.ctx, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	suite.Equal(sdk.NewInt(10), suite.bk.GetBalance(suite.ctx, royaltyAddr, denom).Amount)
+	suite.True(suite.bk.GetBalance(suite.ctx, owner, denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(&suite.keeper)
+	params := fantokentypes.NewParams(
+		[]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))}, nil, nil, 500, nil,
+	)
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), fantokentypes.NewMsgUpdateParams(params, owner.String()))
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Equal(fantokentypes.DefaultParams(), suite.keeper.GetParamSet(suite.ctx))
+
+	// the params are validated
+	invalid := params
+	invalid.MaxRoyaltyBps = fantokentypes.MaxBasisPoints + 1
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), fantokentypes.NewMsgUpdateParams(invalid, suite.keeper.GetAuthority()))
+	suite.Error(err)
+
+	// all the params are replaced at once
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), fantokentypes.NewMsgUpdateParams(params, suite.keeper.GetAuthority()))
+	suite.NoError(err)
+	suite.Equal(params, suite.keeper.GetParamSet(suite.ctx))
+}
+
+func fantokenDenoms(fantokens []fantokentypes.FanToken) []string {
+	var denoms []string
+	for _, fantoken := range fantokens {
+		denoms = append(denoms, fantoken.Denom)
+	}
+	return denoms
+}
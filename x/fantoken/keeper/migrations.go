@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 indexes the existing fantokens by symbol and by minter
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, fantoken := range m.keeper.GetFanTokens(ctx, nil) {
+		m.keeper.setIndexes(ctx, &fantoken)
+	}
+
+	return nil
+}
+
+// Migrate2to3 sets the default maximum royalty of the fantokens
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxRoyaltyBps, types.DefaultParams().MaxRoyaltyBps)
+
+	return nil
+}
+
+// Migrate3to4 migrates the issue, mint and burn fees, stored as single coins,
+// to the lists of accepted fees
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, key := range [][]byte{types.KeyIssueFee, types.KeyMintFee, types.KeyBurnFee} {
+		var fee sdk.Coin
+		if err := json.Unmarshal(m.keeper.paramSpace.GetRaw(ctx, key), &fee); err != nil {
+			return err
+		}
+
+		m.keeper.paramSpace.Set(ctx, key, []sdk.Coin{fee})
+	}
+
+	return nil
+}
+
+// Migrate4to5 sets the empty list of the memo channels, so that no packet
+// carries the fantoken metadata until the governance opts in a channel
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMemoChannels, []string{})
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	// store the fees in the single coin format
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(fantokentypes.ModuleName+"/"))
+	store.Set(fantokentypes.KeyIssueFee, []byte(`{"denom":"ubtsg","amount":"1000"}`))
+	store.Set(fantokentypes.KeyMintFee, []byte(`{"denom":"ubtsg","amount":"0"}`))
+	store.Set(fantokentypes.KeyBurnFee, []byte(`{"denom":"ubtsg","amount":"5"}`))
+
+	suite.NoError(keeper.NewMigrator(suite.keeper).Migrate3to4(suite.ctx))
+
+	params := suite.keeper.GetParamSet(suite.ctx)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin("ubtsg", 1000)}, params.IssueFee)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin("ubtsg", 0)}, params.MintFee)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin("ubtsg", 5)}, params.BurnFee)
+}
+
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	// the memo channels are not stored before the migration
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(fantokentypes.ModuleName+"/"))
+	store.Delete(fantokentypes.KeyMemoChannels)
+
+	suite.NoError(keeper.NewMigrator(suite.keeper).Migrate4to5(suite.ctx))
+
+	suite.Empty(suite.keeper.GetParamSet(suite.ctx).MemoChannels)
+	suite.False(suite.keeper.IsMemoChannel(suite.ctx, "channel-0"))
+}
@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateMintSchedule locks the specified amount of fantoken into a release schedule in favour of the recipient
+func (k Keeper) CreateMintSchedule(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin, scheduleType types.ScheduleType, startTime, endTime time.Time, feeDenom string) (uint64, error) {
+	if recipient.Empty() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidRecipient, "the address %s is not a valid recipient", recipient.String())
+	}
+
+	if minter.Empty() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidMinter, "the address %s is not a valid minter address", minter.String())
+	}
+
+	if k.blockedAddrs[minter.String()] {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", minter.String())
+	}
+
+	if k.blockedAddrs[recipient.String()] {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient.String())
+	}
+
+	if err := types.ValidateAmount(coin.Amount); err != nil {
+		return 0, err
+	}
+
+	if err := types.ValidateScheduleTimes(scheduleType, startTime, endTime); err != nil {
+		return 0, err
+	}
+
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidMintSchedule, "the end time must be in the future")
+	}
+
+	fantoken, err := k.getFanTokenByDenom(ctx, coin.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	if minter.String() != fantoken.Minter {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidMinter, "the address %s is not the minter of the fantoken %s", minter.String(), coin.Denom)
+	}
+
+	if k.IsPaused(ctx, coin.Denom) {
+		return 0, sdkerrors.Wrapf(types.ErrFanTokenPaused, "cannot mint the fantoken %s", coin.Denom)
+	}
+
+	// handle Mint fee
+	if err := k.deductMintFee(ctx, minter, feeDenom); err != nil {
+		return 0, err
+	}
+
+	mintableAmt := k.getMintableAmount(ctx, fantoken)
+	if coin.Amount.GT(mintableAmt) {
+		return 0, sdkerrors.Wrapf(
+			types.ErrInvalidAmount,
+			"the amount exceeds the mintable fantoken amount; expected [0, %s], got %s",
+			mintableAmt, coin.Amount,
+		)
+	}
+
+	id := k.GetLastMintScheduleId(ctx) + 1
+	k.SetLastMintScheduleId(ctx, id)
+
+	schedule := types.NewMintSchedule(id, recipient, coin, scheduleType, startTime, endTime)
+	k.SetMintSchedule(ctx, schedule)
+	k.InsertMintScheduleQueue(ctx, schedule)
+
+	return id, nil
+}
+
+// ReleaseMintSchedules mints the unlocked amount of the due mint schedules to their recipients,
+// and deletes the completed schedules. Only the schedules queued at a release time not after the
+// block time are processed, up to MaxMintScheduleReleases per block, and the not completed ones
+// are queued again at their next release time. The schedules of paused fantokens are skipped
+func (k Keeper) ReleaseMintSchedules(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+
+	for _, queued := range k.getDueMintSchedules(ctx, ctx.BlockTime(), types.MaxMintScheduleReleases) {
+		k.deleteMintScheduleQueue(ctx, queued.releaseTime, queued.id)
+
+		schedule, err := k.GetMintSchedule(ctx, queued.id)
+		if err != nil {
+			panic(err)
+		}
+
+		// the schedules of the paused fantokens are queued behind the due ones
+		if k.IsPaused(ctx, schedule.Denom) {
+			k.setMintScheduleQueue(ctx, ctx.BlockTime(), schedule.Id)
+			continue
+		}
+
+		releasable := schedule.ReleasableAmount(ctx.BlockTime())
+		if releasable.IsPositive() {
+			coin := sdk.NewCoin(schedule.Denom, releasable)
+			recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+
+			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+				panic(err)
+			}
+
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(coin)); err != nil {
+				panic(err)
+			}
+
+			schedule.ReleasedAmount = schedule.ReleasedAmount.Add(releasable)
+
+			ctx.EventManager().EmitTypedEvent(&types.EventReleaseMintSchedule{
+				Id:        schedule.Id,
+				Recipient: schedule.Recipient,
+				Amount:    coin,
+			})
+		}
+
+		if schedule.IsCompleted() {
+			k.deleteMintSchedule(ctx, schedule)
+			logger.Info("mint schedule completed", "id", schedule.Id, "denom", schedule.Denom)
+			continue
+		}
+
+		k.SetMintSchedule(ctx, schedule)
+		k.setMintScheduleQueue(ctx, schedule.NextReleaseTime(ctx.BlockTime()), schedule.Id)
+	}
+}
+
+// getMintableAmount returns the amount of fantoken which can still be minted or locked into a schedule
+func (k Keeper) getMintableAmount(ctx sdk.Context, fantoken types.FanToken) sdk.Int {
+	supply := k.getFanTokenSupply(ctx, fantoken.GetDenom())
+	return fantoken.MaxSupply.Sub(supply).Sub(k.GetLockedAmount(ctx, fantoken.GetDenom()))
+}
+
+// GetLockedAmount returns the amount of fantoken locked into the mint schedules and not yet released
+func (k Keeper) GetLockedAmount(ctx sdk.Context, denom string) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, schedule := range k.GetMintSchedulesByDenom(ctx, denom) {
+		locked = locked.Add(schedule.PendingAmount())
+	}
+	return locked
+}
+
+func (k Keeper) SetLastMintScheduleId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastMintScheduleId, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetLastMintScheduleId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLastMintScheduleId)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetMintSchedule stores (or updates) the mint schedule and its denom index
+func (k Keeper) SetMintSchedule(ctx sdk.Context, schedule types.MintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.KeyMintSchedule(schedule.Id), bz)
+	store.Set(types.KeyMintScheduleByDenom(schedule.Denom, schedule.Id), []byte{0x01})
+}
+
+func (k Keeper) deleteMintSchedule(ctx sdk.Context, schedule types.MintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeyMintSchedule(schedule.Id))
+	store.Delete(types.KeyMintScheduleByDenom(schedule.Denom, schedule.Id))
+}
+
+// GetMintSchedule returns the mint schedule with the specified id
+func (k Keeper) GetMintSchedule(ctx sdk.Context, id uint64) (schedule types.MintSchedule, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyMintSchedule(id))
+	if bz == nil {
+		return schedule, sdkerrors.Wrapf(types.ErrMintScheduleNotExists, "mint schedule %d does not exist", id)
+	}
+
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, nil
+}
+
+// GetMintSchedules returns all the pending mint schedules
+func (k Keeper) GetMintSchedules(ctx sdk.Context) (schedules []types.MintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixMintSchedule)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var schedule types.MintSchedule
+		k.cdc.MustUnmarshal(it.Value(), &schedule)
+
+		schedules = append(schedules, schedule)
+	}
+	return
+}
+
+// GetMintSchedulesByDenom returns the pending mint schedules of the specified fantoken
+func (k Keeper) GetMintSchedulesByDenom(ctx sdk.Context, denom string) (schedules []types.MintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.KeyMintSchedulesByDenom(denom)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		schedule, err := k.GetMintSchedule(ctx, sdk.BigEndianToUint64(it.Key()[len(prefix):]))
+		if err != nil {
+			panic(err)
+		}
+
+		schedules = append(schedules, schedule)
+	}
+	return
+}
+
+// InsertMintScheduleQueue queues the mint schedule at its first release time
+func (k Keeper) InsertMintScheduleQueue(ctx sdk.Context, schedule types.MintSchedule) {
+	k.setMintScheduleQueue(ctx, schedule.NextReleaseTime(schedule.StartTime), schedule.Id)
+}
+
+func (k Keeper) setMintScheduleQueue(ctx sdk.Context, releaseTime time.Time, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyMintScheduleQueue(releaseTime, id), []byte{0x01})
+}
+
+func (k Keeper) deleteMintScheduleQueue(ctx sdk.Context, releaseTime time.Time, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMintScheduleQueue(releaseTime, id))
+}
+
+// queuedMintSchedule is an entry of the mint schedule queue
+type queuedMintSchedule struct {
+	releaseTime time.Time
+	id          uint64
+}
+
+// getDueMintSchedules returns up to limit mint schedules queued at a release time not after the
+// specified time, in order of release time
+func (k Keeper) getDueMintSchedules(ctx sdk.Context, blockTime time.Time, limit int) (queue []queuedMintSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixMintScheduleQueue, sdk.PrefixEndBytes(types.KeyMintSchedulesQueueByTime(blockTime)))
+	defer it.Close()
+
+	for ; it.Valid() && len(queue) < limit; it.Next() {
+		key := it.Key()[len(types.PrefixMintScheduleQueue):]
+
+		releaseTime, err := sdk.ParseTimeBytes(key[:len(key)-8])
+		if err != nil {
+			panic(err)
+		}
+
+		queue = append(queue, queuedMintSchedule{
+			releaseTime: releaseTime,
+			id:          sdk.BigEndianToUint64(key[len(key)-8:]),
+		})
+	}
+	return
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	simapp "github.com/bitsongofficial/go-bitsong/app"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateMintSchedule() {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(startTime)
+
+	// issue a new fantoken
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	// only the minter can create a mint schedule
+	_, err = suite.keeper.CreateMintSchedule(suite.ctx, recipient, recipient, sdk.NewCoin(denom, sdk.NewInt(10)), fantokentypes.ScheduleTypeLinear, startTime, endTime, "")
+	suite.ErrorIs(err, fantokentypes.ErrInvalidMinter)
+
+	// the schedule cannot exceed the max supply
+	_, err = suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, maxSupply.AddRaw(1)), fantokentypes.ScheduleTypeLinear, startTime, endTime, "")
+	suite.ErrorIs(err, fantokentypes.ErrInvalidAmount)
+
+	// lock half of the max supply
+	locked := maxSupply.QuoRaw(2)
+	id, err := suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, locked), fantokentypes.ScheduleTypeLinear, startTime, endTime, "")
+	suite.NoError(err)
+	suite.Equal(uint64(1), id)
+	suite.Equal(locked, suite.keeper.GetLockedAmount(suite.ctx, denom))
+
+	// the locked amount cannot be minted
+	err = suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, maxSupply.Sub(locked).AddRaw(1)), "")
+	suite.ErrorIs(err, fantokentypes.ErrInvalidAmount)
+
+	err = suite.keeper.Mint(suite.ctx, owner, owner, sdk.NewCoin(denom, maxSupply.Sub(locked)), "")
+	suite.NoError(err)
+
+	// nothing more can be locked
+	_, err = suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1)), fantokentypes.ScheduleTypeCliff, startTime, endTime, "")
+	suite.ErrorIs(err, fantokentypes.ErrInvalidAmount)
+}
+
+func (suite *KeeperTestSuite) TestReleaseMintSchedules() {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(startTime)
+
+	// issue a new fantoken
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	linearID, err := suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1000)), fantokentypes.ScheduleTypeLinear, startTime, endTime, "")
+	suite.NoError(err)
+
+	cliffID, err := suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(500)), fantokentypes.ScheduleTypeCliff, startTime, endTime, "")
+	suite.NoError(err)
+
+	// the minter can be disabled without affecting the schedules
+	err = suite.keeper.SetMinter(suite.ctx, denom, owner, sdk.AccAddress{})
+	suite.NoError(err)
+
+	fantoken, err := suite.keeper.GetFanToken(suite.ctx, denom)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(1500), fantoken.GetMaxSupply())
+
+	// a quarter of the linear schedule is released
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(250), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+
+	schedule, err := suite.keeper.GetMintSchedule(suite.ctx, linearID)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(250), schedule.ReleasedAmount)
+	suite.Equal(sdk.NewInt(750), schedule.PendingAmount())
+	suite.Equal(sdk.NewInt(1250), suite.keeper.GetLockedAmount(suite.ctx, denom))
+
+	// nothing is released while the fantoken is paused
+	err = suite.keeper.Pause(suite.ctx, denom, owner)
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(50 * time.Hour))
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(250), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+
+	// the release catches up once unpaused
+	err = suite.keeper.Unpause(suite.ctx, denom, owner)
+	suite.NoError(err)
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(500), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+
+	// both the schedules are completed at the end time
+	suite.ctx = suite.ctx.WithBlockTime(endTime)
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(1500), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+	suite.Equal(sdk.NewInt(1500), suite.bk.GetSupply(suite.ctx, denom).Amount)
+
+	_, err = suite.keeper.GetMintSchedule(suite.ctx, linearID)
+	suite.ErrorIs(err, fantokentypes.ErrMintScheduleNotExists)
+	_, err = suite.keeper.GetMintSchedule(suite.ctx, cliffID)
+	suite.ErrorIs(err, fantokentypes.ErrMintScheduleNotExists)
+	suite.Empty(suite.keeper.GetMintSchedules(suite.ctx))
+	suite.True(suite.keeper.GetLockedAmount(suite.ctx, denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestReleaseMintSchedulesLimit() {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(startTime)
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+
+	// the due schedules are released in order of release time and id
+	for i := 0; i < fantokentypes.MaxMintScheduleReleases; i++ {
+		_, err = suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1)), fantokentypes.ScheduleTypeCliff, startTime, endTime, "")
+		suite.NoError(err)
+	}
+	lateID, err := suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1)), fantokentypes.ScheduleTypeCliff, startTime, endTime.Add(time.Hour), "")
+	suite.NoError(err)
+	lastID, err := suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1)), fantokentypes.ScheduleTypeCliff, startTime, endTime, "")
+	suite.NoError(err)
+
+	// nothing is due before the end time
+	suite.ctx = suite.ctx.WithBlockTime(endTime.Add(-time.Second))
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.True(suite.bk.GetBalance(suite.ctx, recipient, denom).IsZero())
+
+	// the due schedules are released up to the limit of a block
+	suite.ctx = suite.ctx.WithBlockTime(endTime.Add(2 * time.Hour))
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(fantokentypes.MaxMintScheduleReleases), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+
+	_, err = suite.keeper.GetMintSchedule(suite.ctx, lastID)
+	suite.NoError(err)
+	_, err = suite.keeper.GetMintSchedule(suite.ctx, lateID)
+	suite.NoError(err)
+
+	// the remaining ones are released in the next block
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(fantokentypes.MaxMintScheduleReleases+2), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+	suite.Empty(suite.keeper.GetMintSchedules(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMintScheduleQueueGenesis() {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(startTime)
+
+	denom, err := suite.keeper.Issue(suite.ctx, name, symbol, uri, maxSupply, owner, owner, 0, nil, "")
+	suite.NoError(err)
+	_, err = suite.keeper.CreateMintSchedule(suite.ctx, owner, recipient, sdk.NewCoin(denom, sdk.NewInt(1000)), fantokentypes.ScheduleTypeLinear, startTime, endTime, "")
+	suite.NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	suite.keeper.ReleaseMintSchedules(suite.ctx)
+	suite.Equal(sdk.NewInt(250), suite.bk.GetBalance(suite.ctx, recipient, denom).Amount)
+
+	// the imported schedules are queued again
+	genesis := fantoken.ExportGenesis(suite.ctx, suite.keeper)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(startTime.Add(50 * time.Hour))
+	fantoken.InitGenesis(ctx, app.FanTokenKeeper, *genesis)
+
+	// the quarter vested since the export is released
+	app.FanTokenKeeper.ReleaseMintSchedules(ctx)
+	suite.Equal(sdk.NewInt(250), app.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+}
@@ -34,7 +34,16 @@ func (m msgServer) Issue(goCtx context.Context, msg *types.MsgIssue) (*types.Msg
 		return nil, err
 	}
 
-	denom, err := m.Keeper.Issue(ctx, msg.Name, msg.Symbol, msg.URI, msg.MaxSupply, minter, authority)
+	var royaltyAddress sdk.AccAddress
+
+	if msg.RoyaltyAddress != "" {
+		royaltyAddress, err = sdk.AccAddressFromBech32(msg.RoyaltyAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	denom, err := m.Keeper.Issue(ctx, msg.Name, msg.Symbol, msg.URI, msg.MaxSupply, minter, authority, msg.RoyaltyBps, royaltyAddress, msg.FeeDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +54,7 @@ func (m msgServer) Issue(goCtx context.Context, msg *types.MsgIssue) (*types.Msg
 		Denom: denom,
 	})
 
-	return &types.MsgIssueResponse{}, nil
+	return &types.MsgIssueResponse{Denom: denom}, nil
 }
 
 func (m msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
@@ -67,7 +76,7 @@ func (m msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 		recipient = minter
 	}
 
-	if err := m.Keeper.Mint(ctx, minter, recipient, msg.Coin); err != nil {
+	if err := m.Keeper.Mint(ctx, minter, recipient, msg.Coin, msg.FeeDenom); err != nil {
 		return nil, err
 	}
 
@@ -87,7 +96,7 @@ func (m msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 		return nil, err
 	}
 
-	if err := m.Keeper.Burn(ctx, msg.Coin, owner); err != nil {
+	if err := m.Keeper.Burn(ctx, msg.Coin, owner, msg.FeeDenom); err != nil {
 		return nil, err
 	}
 
@@ -192,3 +201,84 @@ func (m msgServer) SetUri(goCtx context.Context, msg *types.MsgSetUri) (*types.M
 
 	return &types.MsgSetUriResponse{}, nil
 }
+
+func (m msgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Pause(ctx, msg.Denom, authority); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&types.EventPause{
+		Denom: msg.Denom,
+	})
+
+	return &types.MsgPauseResponse{}, nil
+}
+
+func (m msgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.Unpause(ctx, msg.Denom, authority); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&types.EventUnpause{
+		Denom: msg.Denom,
+	})
+
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+func (m msgServer) CreateMintSchedule(goCtx context.Context, msg *types.MsgCreateMintSchedule) (*types.MsgCreateMintScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := m.Keeper.CreateMintSchedule(ctx, minter, recipient, msg.Amount, msg.ScheduleType, msg.StartTime, msg.EndTime, msg.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&types.EventCreateMintSchedule{
+		Id:        id,
+		Recipient: recipient.String(),
+		Amount:    msg.Amount,
+	})
+
+	return &types.MsgCreateMintScheduleResponse{Id: id}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.Keeper.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.Keeper.SetParamSet(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -16,3 +16,16 @@ func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// IsMemoChannel returns true if the ICS-20 packets sent on the channel carry
+// the fantoken metadata in their memo
+func (k Keeper) IsMemoChannel(ctx sdk.Context, channel string) bool {
+	var channels []string
+	k.paramSpace.Get(ctx, types.KeyMemoChannels, &channels)
+	for _, c := range channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetRoyalty returns the royalty due on the transfer of the specified coin and
+// the address receiving it. The royalty is truncated, so it is zero for the
+// fantokens without royalty, for the small amounts and for the non fantokens
+func (k Keeper) GetRoyalty(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, sdk.AccAddress) {
+	royalty := sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+
+	fantoken, err := k.getFanTokenByDenom(ctx, coin.Denom)
+	if err != nil || fantoken.RoyaltyBps == 0 {
+		return royalty, nil
+	}
+
+	recipient := fantoken.GetRoyaltyRecipient()
+	if recipient.Empty() {
+		return royalty, nil
+	}
+
+	royalty.Amount = coin.Amount.MulRaw(int64(fantoken.RoyaltyBps)).QuoRaw(types.MaxBasisPoints)
+
+	return royalty, recipient
+}
+
+// PayRoyalties sends from the sender to the royalty recipients the royalties
+// due on the transfer of the specified coins. The sender must be able to pay
+// both the transferred coins and the royalties
+func (k Keeper) PayRoyalties(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		royalty, recipient := k.GetRoyalty(ctx, coin)
+		if !royalty.IsPositive() || recipient.Equals(sender) {
+			continue
+		}
+
+		spendable := k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(coin.Denom)
+		if spendable.LT(coin.Amount.Add(royalty.Amount)) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds,
+				"%s%s is smaller than %s plus the royalty of %s", spendable, coin.Denom, coin, royalty,
+			)
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(royalty)); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitTypedEvent(&types.EventRoyalty{
+			Denom:     coin.Denom,
+			Sender:    sender.String(),
+			Recipient: recipient.String(),
+			Amount:    royalty,
+		})
+	}
+
+	return nil
+}
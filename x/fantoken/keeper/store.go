@@ -20,12 +20,35 @@ func (k Keeper) setWithMetadataAuthority(ctx sdk.Context, owner sdk.AccAddress,
 	store.Set(types.KeyFanTokens(owner, denom), bz)
 }
 
+// setSymbolIndex indexes the fantoken by its symbol
+func (k Keeper) setSymbolIndex(ctx sdk.Context, symbol, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFanTokenBySymbol(symbol, denom), []byte{0x01})
+}
+
+// setMinterIndex indexes the fantoken by its minter
+func (k Keeper) setMinterIndex(ctx sdk.Context, minter sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFanTokenByMinter(minter, denom), []byte{0x01})
+}
+
+// deleteMinterIndex removes the fantoken from the index of the minter
+func (k Keeper) deleteMinterIndex(ctx sdk.Context, minter sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFanTokenByMinter(minter, denom))
+}
+
 func (k Keeper) setFanToken(ctx sdk.Context, token *types.FanToken) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(token)
 	store.Set(types.KeyDenom(token.GetDenom()), bz)
 }
 
+// setDenomMetaData stores (or updates) the x/bank denom metadata of the fantoken
+func (k Keeper) setDenomMetaData(ctx sdk.Context, token *types.FanToken) {
+	k.bankKeeper.SetDenomMetaData(ctx, token.GetBankMetadata())
+}
+
 func (k Keeper) getFanTokenByDenom(ctx sdk.Context, denom string) (fantoken types.FanToken, err error) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -48,3 +71,67 @@ func (k Keeper) resetStoreKeyForQueryToken(ctx sdk.Context, denom string, srcOwn
 	// add the new key
 	k.setWithMetadataAuthority(ctx, dstOwner, denom)
 }
+
+// SetPaused marks the transfers of the specified fantoken as paused
+func (k Keeper) SetPaused(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPaused(denom), []byte{0x01})
+}
+
+// deletePaused resumes the transfers of the specified fantoken
+func (k Keeper) deletePaused(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPaused(denom))
+}
+
+// IsPaused returns true if the transfers of the specified fantoken are paused
+func (k Keeper) IsPaused(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPaused(denom))
+}
+
+// GetPausedDenoms returns the denoms of all the paused fantokens
+func (k Keeper) GetPausedDenoms(ctx sdk.Context) (denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixPausedFanToken)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		denoms = append(denoms, string(it.Key()[len(types.PrefixPausedFanToken):]))
+	}
+	return
+}
+
+// setVerifiedSymbol reserves the symbol to the fantoken with the specified denom
+func (k Keeper) setVerifiedSymbol(ctx sdk.Context, symbol, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyVerifiedSymbol(symbol), []byte(denom))
+}
+
+// GetVerifiedDenom returns the denom of the fantoken the symbol is reserved to
+func (k Keeper) GetVerifiedDenom(ctx sdk.Context, symbol string) (denom string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyVerifiedSymbol(symbol))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetVerifiedSymbols returns all the symbols reserved to a verified fantoken
+func (k Keeper) GetVerifiedSymbols(ctx sdk.Context) (verifiedSymbols []types.VerifiedSymbol) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixVerifiedSymbol)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		verifiedSymbols = append(verifiedSymbols, types.VerifiedSymbol{
+			Symbol: string(it.Key()[len(types.PrefixVerifiedSymbol):]),
+			Denom:  string(it.Value()),
+		})
+	}
+	return
+}
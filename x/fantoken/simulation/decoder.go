@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding fantoken type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PrefixFanTokenForDenom):
+			var fantokenA, fantokenB types.FanToken
+			cdc.MustUnmarshal(kvA.Value, &fantokenA)
+			cdc.MustUnmarshal(kvB.Value, &fantokenB)
+			return fmt.Sprintf("%v\n%v", fantokenA, fantokenB)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixFanTokens):
+			var denomA, denomB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &denomA)
+			cdc.MustUnmarshal(kvB.Value, &denomB)
+			return fmt.Sprintf("%v\n%v", denomA.Value, denomB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixPausedFanToken),
+			bytes.Equal(kvA.Key[:1], types.PrefixMintScheduleByDenom),
+			bytes.Equal(kvA.Key[:1], types.PrefixFanTokenBySymbol),
+			bytes.Equal(kvA.Key[:1], types.PrefixFanTokenByMinter):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixVerifiedSymbol):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixMintSchedule):
+			var scheduleA, scheduleB types.MintSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyLastMintScheduleId):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid fantoken key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// Simulation parameter constants
+const (
+	IssueFee      = "issue_fee"
+	MintFee       = "mint_fee"
+	BurnFee       = "burn_fee"
+	MaxRoyaltyBps = "max_royalty_bps"
+	MemoChannels  = "memo_channels"
+	FanTokens     = "fan_tokens"
+)
+
+// GenFee randomizes the accepted fees: none, or a fee in the bond denom
+func GenFee(r *rand.Rand, max int64) []sdk.Coin {
+	if r.Intn(10) == 0 {
+		return []sdk.Coin{}
+	}
+	return []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(max))}
+}
+
+// RandomSymbol returns a random fantoken symbol, made of lowercase letters and numbers
+func RandomSymbol(r *rand.Rand) string {
+	return strings.ToLower(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 3, 10)))
+}
+
+// RandomFanToken returns a fantoken with a random sim account as minter and authority
+func RandomFanToken(r *rand.Rand, accs []simtypes.Account) types.FanToken {
+	owner, _ := simtypes.RandomAcc(r, accs)
+	maxSupply := sdk.NewInt(r.Int63n(1_000_000_000_000) + 1)
+
+	return *types.NewFanToken(
+		simtypes.RandStringOfLength(r, 10), RandomSymbol(r), fmt.Sprintf("ipfs://%s", simtypes.RandStringOfLength(r, 20)),
+		maxSupply, owner.Address, owner.Address, 0,
+	)
+}
+
+// RandomizedGenState generates a random GenesisState for the fantoken module
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		issueFee      []sdk.Coin
+		mintFee       []sdk.Coin
+		burnFee       []sdk.Coin
+		maxRoyaltyBps uint32
+		memoChannels  []string
+		fantokens     []types.FanToken
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, IssueFee, &issueFee, simState.Rand,
+		func(r *rand.Rand) { issueFee = GenFee(r, 1_000_000) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintFee, &mintFee, simState.Rand,
+		func(r *rand.Rand) { mintFee = GenFee(r, 1_000) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnFee, &burnFee, simState.Rand,
+		func(r *rand.Rand) { burnFee = GenFee(r, 1_000) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRoyaltyBps, &maxRoyaltyBps, simState.Rand,
+		func(r *rand.Rand) { maxRoyaltyBps = uint32(r.Intn(types.MaxBasisPoints + 1)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MemoChannels, &memoChannels, simState.Rand,
+		func(r *rand.Rand) {
+			if r.Intn(2) == 0 {
+				memoChannels = []string{"channel-0"}
+			}
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FanTokens, &fantokens, simState.Rand,
+		func(r *rand.Rand) {
+			denoms := make(map[string]bool)
+			n := r.Intn(5)
+			for i := 0; i < n; i++ {
+				fantoken := RandomFanToken(r, simState.Accounts)
+				if denoms[fantoken.GetDenom()] {
+					continue
+				}
+				denoms[fantoken.GetDenom()] = true
+				fantokens = append(fantokens, fantoken)
+			}
+		},
+	)
+
+	fantokenGenesis := types.NewGenesisState(types.NewParams(issueFee, mintFee, burnFee, maxRoyaltyBps, memoChannels), fantokens)
+
+	bz, err := json.MarshalIndent(&fantokenGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fantoken parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&fantokenGenesis)
+}
@@ -0,0 +1,352 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgIssue        = "op_weight_msg_issue"
+	OpWeightMsgMint         = "op_weight_msg_mint"
+	OpWeightMsgBurn         = "op_weight_msg_burn"
+	OpWeightMsgSetMinter    = "op_weight_msg_set_minter"
+	OpWeightMsgSetAuthority = "op_weight_msg_set_authority"
+	OpWeightMsgSetUri       = "op_weight_msg_set_uri"
+	OpWeightMsgDisableMint  = "op_weight_msg_disable_mint"
+
+	DefaultWeightMsgIssue        = 20
+	DefaultWeightMsgMint         = 50
+	DefaultWeightMsgBurn         = 30
+	DefaultWeightMsgSetMinter    = 10
+	DefaultWeightMsgSetAuthority = 10
+	DefaultWeightMsgSetUri       = 10
+	DefaultWeightMsgDisableMint  = 2
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgIssue        int
+		weightMsgMint         int
+		weightMsgBurn         int
+		weightMsgSetMinter    int
+		weightMsgSetAuthority int
+		weightMsgSetUri       int
+		weightMsgDisableMint  int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgIssue, &weightMsgIssue, nil,
+		func(_ *rand.Rand) { weightMsgIssue = DefaultWeightMsgIssue },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) { weightMsgMint = DefaultWeightMsgMint },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) { weightMsgBurn = DefaultWeightMsgBurn },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetMinter, &weightMsgSetMinter, nil,
+		func(_ *rand.Rand) { weightMsgSetMinter = DefaultWeightMsgSetMinter },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAuthority, &weightMsgSetAuthority, nil,
+		func(_ *rand.Rand) { weightMsgSetAuthority = DefaultWeightMsgSetAuthority },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetUri, &weightMsgSetUri, nil,
+		func(_ *rand.Rand) { weightMsgSetUri = DefaultWeightMsgSetUri },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgDisableMint, &weightMsgDisableMint, nil,
+		func(_ *rand.Rand) { weightMsgDisableMint = DefaultWeightMsgDisableMint },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgIssue, SimulateMsgIssue(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgMint, SimulateMsgMint(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgBurn, SimulateMsgBurn(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgSetMinter, SimulateMsgSetMinter(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgSetAuthority, SimulateMsgSetAuthority(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgSetUri, SimulateMsgSetUri(k, ak, bk)),
+		simulation.NewWeightedOperation(weightMsgDisableMint, SimulateMsgDisableMint(k, ak, bk)),
+	}
+}
+
+// SimulateMsgIssue simulates the issue of a new fantoken
+func SimulateMsgIssue(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		fantoken := RandomFanToken(r, []simtypes.Account{simAccount})
+
+		if k.HasFanToken(ctx, fantoken.GetDenom()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgIssue, "fantoken already exists"), nil, nil
+		}
+
+		issueFee := randomFee(r, k.GetParamSet(ctx).IssueFee)
+		if !hasFee(ctx, bk, simAccount.Address, issueFee) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgIssue, "insufficient funds for the issue fee"), nil, nil
+		}
+
+		msg := types.NewMsgIssue(fantoken.GetName(), fantoken.GetSymbol(), fantoken.GetURI(), fantoken.GetMaxSupply(), simAccount.Address.String())
+		msg.Minter = simAccount.Address.String()
+		msg.FeeDenom = issueFee.Denom
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, feeCoins(issueFee))
+	}
+}
+
+// SimulateMsgMint simulates the mint of a fantoken to a random recipient
+func SimulateMsgMint(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		fantoken, minter, found := randomFanToken(r, ctx, k, accs, func(fantoken types.FanToken) string {
+			return fantoken.Minter
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no fantoken with a sim account as minter"), nil, nil
+		}
+
+		if k.IsPaused(ctx, fantoken.GetDenom()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "fantoken paused"), nil, nil
+		}
+
+		supply := bk.GetSupply(ctx, fantoken.GetDenom()).Amount
+		mintable := fantoken.GetMaxSupply().Sub(supply).Sub(k.GetLockedAmount(ctx, fantoken.GetDenom()))
+		if !mintable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "max supply reached"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, mintable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, err.Error()), nil, err
+		}
+
+		mintFee := randomFee(r, k.GetParamSet(ctx).MintFee)
+		if !hasFee(ctx, bk, minter.Address, mintFee) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "insufficient funds for the mint fee"), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgMint(recipient.Address.String(), sdk.NewCoin(fantoken.GetDenom(), amount), minter.Address.String())
+		msg.FeeDenom = mintFee.Denom
+
+		return deliver(r, app, ctx, ak, bk, minter, msg, feeCoins(mintFee))
+	}
+}
+
+// SimulateMsgBurn simulates the burn of the fantokens of a random account
+func SimulateMsgBurn(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, balances, found := randomFanTokenHolder(r, ctx, k, bk, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no fantoken to burn"), nil, nil
+		}
+
+		balance := balances[r.Intn(len(balances))]
+		amount, err := simtypes.RandPositiveInt(r, balance.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, err.Error()), nil, err
+		}
+		coin := sdk.NewCoin(balance.Denom, amount)
+
+		burnFee := randomFee(r, k.GetParamSet(ctx).BurnFee)
+		if !hasFee(ctx, bk, simAccount.Address, burnFee) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "insufficient funds for the burn fee"), nil, nil
+		}
+
+		msg := types.NewMsgBurn(coin, simAccount.Address.String())
+		msg.FeeDenom = burnFee.Denom
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, feeCoins(burnFee).Add(coin))
+	}
+}
+
+// SimulateMsgSetMinter simulates the transfer of the minter role of a fantoken to a random account
+func SimulateMsgSetMinter(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		fantoken, minter, found := randomFanToken(r, ctx, k, accs, func(fantoken types.FanToken) string {
+			return fantoken.Minter
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetMinter, "no fantoken with a sim account as minter"), nil, nil
+		}
+
+		newMinter, _ := simtypes.RandomAcc(r, accs)
+		if newMinter.Address.Equals(minter.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetMinter, "same minter"), nil, nil
+		}
+
+		msg := types.NewMsgSetMinter(fantoken.GetDenom(), minter.Address.String(), newMinter.Address.String())
+
+		return deliver(r, app, ctx, ak, bk, minter, msg, nil)
+	}
+}
+
+// SimulateMsgSetAuthority simulates the transfer of the authority of a fantoken to a random account
+func SimulateMsgSetAuthority(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		fantoken, authority, found := randomFanToken(r, ctx, k, accs, func(fantoken types.FanToken) string {
+			return fantoken.MetaData.Authority
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAuthority, "no fantoken with a sim account as authority"), nil, nil
+		}
+
+		newAuthority, _ := simtypes.RandomAcc(r, accs)
+		if newAuthority.Address.Equals(authority.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAuthority, "same authority"), nil, nil
+		}
+
+		msg := types.NewMsgSetAuthority(fantoken.GetDenom(), authority.Address.String(), newAuthority.Address.String())
+
+		return deliver(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgSetUri simulates the update of the uri of a fantoken
+func SimulateMsgSetUri(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		fantoken, authority, found := randomFanToken(r, ctx, k, accs, func(fantoken types.FanToken) string {
+			return fantoken.MetaData.Authority
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetUri, "no fantoken with a sim account as authority"), nil, nil
+		}
+
+		uri := fmt.Sprintf("ipfs://%s", simtypes.RandStringOfLength(r, 20))
+		msg := types.NewMsgSetUri(fantoken.GetDenom(), uri, authority.Address.String())
+
+		return deliver(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgDisableMint simulates the disabling of the mint of a fantoken
+func SimulateMsgDisableMint(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		fantoken, minter, found := randomFanToken(r, ctx, k, accs, func(fantoken types.FanToken) string {
+			return fantoken.Minter
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEdit, "no fantoken with a sim account as minter"), nil, nil
+		}
+
+		msg := types.NewMsgDisableMint(fantoken.GetDenom(), minter.Address.String())
+
+		return deliver(r, app, ctx, ak, bk, minter, msg, nil)
+	}
+}
+
+// randomFanToken returns a random fantoken whose role, returned by getRole, is held by a sim account
+func randomFanToken(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, getRole func(types.FanToken) string,
+) (types.FanToken, simtypes.Account, bool) {
+	fantokens := k.GetFanTokens(ctx, nil)
+	if len(fantokens) == 0 {
+		return types.FanToken{}, simtypes.Account{}, false
+	}
+
+	offset := r.Intn(len(fantokens))
+	for i := range fantokens {
+		fantoken := fantokens[(offset+i)%len(fantokens)]
+
+		addr, err := sdk.AccAddressFromBech32(getRole(fantoken))
+		if err != nil {
+			continue
+		}
+
+		if simAccount, found := simtypes.FindAccount(accs, addr); found {
+			return fantoken, simAccount, true
+		}
+	}
+
+	return types.FanToken{}, simtypes.Account{}, false
+}
+
+// randomFanTokenHolder returns a sim account, starting from a random one, holding fantokens
+// which are not paused, together with its spendable fantokens
+func randomFanTokenHolder(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, accs []simtypes.Account,
+) (simtypes.Account, sdk.Coins, bool) {
+	offset := r.Intn(len(accs))
+	for i := range accs {
+		simAccount := accs[(offset+i)%len(accs)]
+
+		var balances sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if k.HasFanToken(ctx, coin.Denom) && !k.IsPaused(ctx, coin.Denom) {
+				balances = append(balances, coin)
+			}
+		}
+
+		if !balances.Empty() {
+			return simAccount, balances, true
+		}
+	}
+
+	return simtypes.Account{}, nil, false
+}
+
+// hasFee returns true if the account can pay the fee
+// randomFee picks one of the accepted fees, the zero coin when there is none
+func randomFee(r *rand.Rand, fees []sdk.Coin) sdk.Coin {
+	if len(fees) == 0 {
+		return sdk.Coin{}
+	}
+	return fees[r.Intn(len(fees))]
+}
+
+func hasFee(ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress, fee sdk.Coin) bool {
+	return bk.SpendableCoins(ctx, addr).IsAllGTE(feeCoins(fee))
+}
+
+func feeCoins(fee sdk.Coin) sdk.Coins {
+	if fee.IsNil() || fee.IsZero() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(fee)
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		CoinsSpentInMsg: coinsSpent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
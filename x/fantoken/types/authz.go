@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &HolderAuthorization{}
+
+// NewHolderAuthorization creates a new HolderAuthorization
+func NewHolderAuthorization(msgTypeURL, denom string, minAmount sdk.Int) *HolderAuthorization {
+	return &HolderAuthorization{
+		MsgTypeUrl: msgTypeURL,
+		Denom:      denom,
+		MinAmount:  minAmount,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a HolderAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. The authorization has no access to
+// the grantee nor to the balances, the holding of the grantee is checked by
+// the fantoken authz msg server before the execution of each message
+func (a HolderAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a HolderAuthorization) ValidateBasic() error {
+	if len(a.MsgTypeUrl) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "empty msg type url")
+	}
+
+	return ValidateHolderThreshold(a.Denom, a.MinAmount)
+}
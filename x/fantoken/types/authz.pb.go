@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bitsong/fantoken/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HolderAuthorization authorizes the grantee to execute the messages of a type
+// on behalf of the granter only while the grantee holds at least the min
+// amount of a fantoken
+type HolderAuthorization struct {
+	// msg_type_url is the type URL of the authorized messages
+	MsgTypeUrl string                                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Denom      string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinAmount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+}
+
+func (m *HolderAuthorization) Reset()         { *m = HolderAuthorization{} }
+func (m *HolderAuthorization) String() string { return proto.CompactTextString(m) }
+func (*HolderAuthorization) ProtoMessage()    {}
+func (*HolderAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1489ef87e65a053c, []int{0}
+}
+func (m *HolderAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HolderAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HolderAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderAuthorization.Merge(m, src)
+}
+func (m *HolderAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderAuthorization proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*HolderAuthorization)(nil), "bitsong.fantoken.v1beta1.HolderAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("bitsong/fantoken/v1beta1/authz.proto", fileDescriptor_1489ef87e65a053c)
+}
+
+var fileDescriptor_1489ef87e65a053c = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0x87, 0xe3, 0x7b, 0x05, 0x52, 0x2d, 0x18, 0x08, 0x1d, 0x42, 0x07, 0xb7, 0x42, 0x08, 0xb1,
+	0xd4, 0x56, 0x85, 0xc4, 0xc0, 0xd6, 0x4e, 0x30, 0xb0, 0x54, 0x65, 0x61, 0x89, 0x9c, 0xd6, 0x75,
+	0xad, 0xc6, 0x3e, 0x55, 0xec, 0x20, 0xda, 0xa7, 0xe0, 0x4d, 0x58, 0x78, 0x88, 0x8e, 0x15, 0x13,
+	0x62, 0xa8, 0x20, 0x79, 0x11, 0x94, 0x3f, 0x15, 0x30, 0xd9, 0xe7, 0x9c, 0xcf, 0xfe, 0x1d, 0x7d,
+	0xf8, 0x2c, 0x52, 0xce, 0x82, 0x91, 0x6c, 0xca, 0x8d, 0x83, 0xb9, 0x30, 0xec, 0xb1, 0x17, 0x09,
+	0xc7, 0x7b, 0x8c, 0xa7, 0x6e, 0xb6, 0xa2, 0x8b, 0x04, 0x1c, 0xf8, 0x41, 0x4d, 0xd1, 0x1d, 0x45,
+	0x6b, 0xaa, 0x75, 0x32, 0x06, 0xab, 0xc1, 0x86, 0x25, 0xc7, 0xaa, 0xa2, 0x7a, 0xd4, 0x6a, 0x4a,
+	0x90, 0x50, 0xf5, 0x8b, 0x5b, 0xd5, 0x3d, 0x7d, 0x41, 0xf8, 0xf8, 0x06, 0xe2, 0x89, 0x48, 0xfa,
+	0xa9, 0x9b, 0x41, 0xa2, 0x56, 0xdc, 0x29, 0x30, 0x7e, 0x07, 0x1f, 0x68, 0x2b, 0x43, 0xb7, 0x5c,
+	0x88, 0x30, 0x4d, 0xe2, 0x00, 0x75, 0xd0, 0x45, 0x63, 0x88, 0xb5, 0x95, 0xa3, 0xe5, 0x42, 0xdc,
+	0x27, 0xb1, 0xdf, 0xc4, 0x7b, 0x13, 0x61, 0x40, 0x07, 0xff, 0xca, 0x51, 0x55, 0xf8, 0x77, 0x18,
+	0x6b, 0x65, 0x42, 0xae, 0x21, 0x35, 0x2e, 0xf8, 0x5f, 0x8c, 0x06, 0x74, 0xbd, 0x6d, 0x7b, 0x1f,
+	0xdb, 0xf6, 0xb9, 0x54, 0x6e, 0x96, 0x46, 0x74, 0x0c, 0xba, 0x5e, 0xad, 0x3e, 0xba, 0x76, 0x32,
+	0x67, 0x45, 0x94, 0xa5, 0xb7, 0xc6, 0x0d, 0x1b, 0x5a, 0x99, 0x7e, 0xf9, 0xc1, 0xf5, 0xd1, 0xdb,
+	0x6b, 0xf7, 0xf0, 0xcf, 0x66, 0x83, 0xd1, 0xfa, 0x8b, 0x78, 0xeb, 0x8c, 0xa0, 0x4d, 0x46, 0xd0,
+	0x67, 0x46, 0xd0, 0x73, 0x4e, 0xbc, 0x4d, 0x4e, 0xbc, 0xf7, 0x9c, 0x78, 0x0f, 0x57, 0xbf, 0x32,
+	0x6a, 0x4b, 0x30, 0x9d, 0xaa, 0xb1, 0xe2, 0x31, 0x93, 0xd0, 0xdd, 0xe9, 0x7d, 0xfa, 0x11, 0x5c,
+	0xe6, 0x46, 0xfb, 0xa5, 0x8e, 0xcb, 0xef, 0x01, 0x00, 0x64, 0x1d, 0xa2, 0x49, 0x81, 0x01, 0x00,
+	0x00,
+}
+
+func (m *HolderAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HolderAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HolderAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -28,7 +29,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetAuthority{}, "go-bitsong/fantoken/MsgSetAuthority", nil)
 	cdc.RegisterConcrete(&MsgSetMinter{}, "go-bitsong/fantoken/MsgSetMinter", nil)
 	cdc.RegisterConcrete(&MsgSetUri{}, "go-bitsong/fantoken/MsgSetUri", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "go-bitsong/fantoken/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "go-bitsong/fantoken/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgCreateMintSchedule{}, "go-bitsong/fantoken/MsgCreateMintSchedule", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "go-bitsong/fantoken/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&UpdateFeesProposal{}, "go-bitsong/fantoken/UpdateFeesProposal", nil)
+	cdc.RegisterConcrete(&RegisterVerifiedSymbolProposal{}, "go-bitsong/fantoken/RegisterVerifiedSymbolProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "go-bitsong/fantoken/UpdateParamsProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -40,11 +47,22 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetAuthority{},
 		&MsgSetMinter{},
 		&MsgSetUri{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgCreateMintSchedule{},
+		&MsgUpdateParams{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdateFeesProposal{},
+		&RegisterVerifiedSymbolProposal{},
+		&UpdateParamsProposal{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&HolderAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
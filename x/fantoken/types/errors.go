@@ -21,4 +21,15 @@ var (
 	ErrNotFoundTokenAmt   = sdkerrors.Register(ModuleName, 12, "burned fantoken amount not found")
 	ErrInvalidAmount      = sdkerrors.Register(ModuleName, 13, "invalid amount")
 	ErrInvalidUri         = sdkerrors.Register(ModuleName, 14, "invalid uri length")
+	ErrFanTokenPaused     = sdkerrors.Register(ModuleName, 15, "fantoken transfers are paused")
+	ErrFanTokenNotPaused  = sdkerrors.Register(ModuleName, 16, "fantoken transfers are not paused")
+
+	ErrInvalidMintSchedule   = sdkerrors.Register(ModuleName, 17, "invalid mint schedule")
+	ErrMintScheduleNotExists = sdkerrors.Register(ModuleName, 18, "mint schedule does not exist")
+
+	ErrSymbolReserved = sdkerrors.Register(ModuleName, 19, "symbol reserved to a verified fantoken")
+
+	ErrInvalidRoyalty = sdkerrors.Register(ModuleName, 20, "invalid fantoken royalty")
+
+	ErrNotHolder = sdkerrors.Register(ModuleName, 21, "not a fantoken holder")
 )
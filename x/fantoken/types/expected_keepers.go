@@ -2,23 +2,30 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-type DistrKeeper interface {
-	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+// FeesKeeper defines the expected fees keeper distributing the module fees
+type FeesKeeper interface {
+	DistributeFees(ctx sdk.Context, module, action string, payer sdk.AccAddress, fees sdk.Coins) error
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramstypes.ParamSet)
+	Get(ctx sdk.Context, key []byte, ptr interface{})
 	SetParamSet(ctx sdk.Context, ps paramstypes.ParamSet)
+	Set(ctx sdk.Context, key []byte, value interface{})
+	GetRaw(ctx sdk.Context, key []byte) []byte
 	HasKeyTable() bool
 	WithKeyTable(table paramstypes.KeyTable) paramstypes.Subspace
 }
 
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
 // BankKeeper defines the expected bank keeper (noalias)
@@ -28,8 +35,12 @@ type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 
-	//GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	//SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
-	//SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
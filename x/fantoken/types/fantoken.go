@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 	"gopkg.in/yaml.v2"
 
@@ -61,6 +64,16 @@ func (ft FanToken) GetMinter() sdk.AccAddress {
 	return minter
 }
 
+// GetRoyaltyRecipient returns the address receiving the royalties of the fantoken,
+// which is the royalty address if set, otherwise the authority
+func (ft FanToken) GetRoyaltyRecipient() sdk.AccAddress {
+	if len(ft.RoyaltyAddress) > 0 {
+		recipient, _ := sdk.AccAddressFromBech32(ft.RoyaltyAddress)
+		return recipient
+	}
+	return ft.GetAuthority()
+}
+
 // GetURI implements exported.FanTokenI
 func (ft FanToken) GetURI() string {
 	return ft.MetaData.URI
@@ -71,6 +84,37 @@ func (ft FanToken) GetMetaData() Metadata {
 	return ft.MetaData
 }
 
+// GetDisplayDenom returns the denom unit used by clients to display the fantoken.
+// It is the symbol itself, or the symbol prefixed with "ft" when the symbol is
+// not a valid coin denom (eg: shorter than 3 characters or starting with a number)
+func (ft FanToken) GetDisplayDenom() string {
+	display := ft.MetaData.Symbol
+	if err := sdk.ValidateDenom(display); err != nil {
+		display = "ft" + display
+	}
+	return display
+}
+
+// GetBankMetadata returns the x/bank denom metadata of the fantoken
+func (ft FanToken) GetBankMetadata() banktypes.Metadata {
+	name := ft.MetaData.Name
+	if len(strings.TrimSpace(name)) == 0 {
+		name = ft.MetaData.Symbol
+	}
+
+	return banktypes.Metadata{
+		Description: ft.MetaData.URI,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ft.Denom, Exponent: 0},
+			{Denom: ft.GetDisplayDenom(), Exponent: FanTokenDecimal},
+		},
+		Base:    ft.Denom,
+		Display: ft.GetDisplayDenom(),
+		Name:    name,
+		Symbol:  strings.ToUpper(ft.MetaData.Symbol),
+	}
+}
+
 func (ft FanToken) String() string {
 	bz, _ := yaml.Marshal(ft)
 	return string(bz)
@@ -83,6 +127,10 @@ func (ft FanToken) Validate() error {
 		}
 	}
 
+	if err := ValidateRoyalty(ft.RoyaltyBps, ft.RoyaltyAddress); err != nil {
+		return err
+	}
+
 	return ft.MetaData.Validate()
 }
 
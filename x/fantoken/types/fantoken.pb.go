@@ -78,6 +78,11 @@ type FanToken struct {
 	// sdk.AccAddress allowed to mint new fantoken
 	Minter   string   `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
 	MetaData Metadata `protobuf:"bytes,4,opt,name=meta_data,json=metaData,proto3" json:"meta_data" yaml:"meta_data"`
+	// royalty_bps is the royalty, in basis points, collected on the transfers
+	// of the fantoken
+	RoyaltyBps uint32 `protobuf:"varint,5,opt,name=royalty_bps,json=royaltyBps,proto3" json:"royalty_bps,omitempty" yaml:"royalty_bps"`
+	// sdk.AccAddress receiving the royalties, the authority when empty
+	RoyaltyAddress string `protobuf:"bytes,6,opt,name=royalty_address,json=royaltyAddress,proto3" json:"royalty_address,omitempty" yaml:"royalty_address"`
 }
 
 func (m *FanToken) Reset()      { *m = FanToken{} }
@@ -122,33 +127,37 @@ func init() {
 }
 
 var fileDescriptor_af4dfaf3dfee0855 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xb5, 0x73, 0x21, 0xdc, 0x2d, 0x0d, 0xac, 0x22, 0x64, 0x22, 0xb4, 0x46, 0x2e, 0x80, 0x26,
-	0xb6, 0x02, 0x12, 0x45, 0xca, 0x03, 0x21, 0xa5, 0xa0, 0x31, 0xa1, 0x80, 0x26, 0x1a, 0xdf, 0xed,
-	0x39, 0xab, 0xdc, 0xee, 0x9c, 0xbc, 0x6b, 0x74, 0x2e, 0xe9, 0x28, 0x29, 0x29, 0xf3, 0x39, 0x57,
-	0xa6, 0x44, 0x14, 0x16, 0xf8, 0xfe, 0x20, 0x5f, 0x80, 0x76, 0xbd, 0x77, 0x47, 0x93, 0x6a, 0xdf,
-	0x3c, 0xcd, 0xcc, 0x9b, 0x37, 0x3b, 0xe4, 0x45, 0x21, 0x8c, 0x46, 0x55, 0x66, 0x33, 0x50, 0x06,
-	0xaf, 0xb8, 0xca, 0xbe, 0x9e, 0x14, 0xdc, 0xc0, 0xc9, 0x96, 0x48, 0x17, 0x15, 0x1a, 0xa4, 0x91,
-	0x4f, 0x4c, 0xb7, 0xbc, 0x4f, 0x3c, 0x62, 0x13, 0xd4, 0x12, 0x75, 0x56, 0x80, 0xe6, 0xdb, 0xea,
-	0x09, 0x0a, 0x5f, 0x79, 0x74, 0x58, 0x62, 0x89, 0x0e, 0x66, 0x16, 0xf5, 0x6c, 0x82, 0x64, 0xf8,
-	0x81, 0x1b, 0x98, 0x82, 0x01, 0x4a, 0xc9, 0xbe, 0x02, 0xc9, 0xa3, 0xf0, 0x59, 0xf8, 0x72, 0x94,
-	0x3b, 0x4c, 0x1f, 0x93, 0x03, 0xdd, 0xc8, 0x02, 0xe7, 0xd1, 0x9e, 0x63, 0x7d, 0x44, 0x9f, 0x90,
-	0x41, 0x5d, 0x89, 0x68, 0x60, 0xc9, 0xf1, 0xfd, 0xae, 0x8d, 0x07, 0x9f, 0xf2, 0xb3, 0xdc, 0x72,
-	0xf4, 0x29, 0x19, 0x41, 0x6d, 0x2e, 0xb1, 0x12, 0xa6, 0x89, 0xf6, 0x5d, 0xd5, 0x8e, 0x48, 0xbe,
-	0xed, 0x91, 0xe1, 0x7b, 0x50, 0xe7, 0x76, 0x76, 0x7a, 0x48, 0xee, 0x4d, 0xb9, 0x42, 0xe9, 0x25,
-	0xfb, 0x80, 0x16, 0x84, 0x48, 0x58, 0x5e, 0xe8, 0x7a, 0xb1, 0x98, 0x37, 0xbd, 0xee, 0xf8, 0xed,
-	0xaa, 0x8d, 0x83, 0xdf, 0x6d, 0xfc, 0xbc, 0x14, 0xe6, 0xb2, 0x2e, 0xd2, 0x09, 0xca, 0xcc, 0x1b,
-	0xee, 0x9f, 0x63, 0x3d, 0xbd, 0xca, 0x4c, 0xb3, 0xe0, 0x3a, 0x3d, 0x53, 0xe6, 0xb6, 0x8d, 0x1f,
-	0x35, 0x20, 0xe7, 0xa7, 0xc9, 0xae, 0x53, 0x92, 0x8f, 0x24, 0x2c, 0x3f, 0x3a, 0x6c, 0x7d, 0x49,
-	0xa1, 0x0c, 0xaf, 0x7a, 0x0b, 0xb9, 0x8f, 0xe8, 0x67, 0x32, 0x92, 0xdc, 0xc0, 0x85, 0x5d, 0x88,
-	0x1b, 0xfe, 0xc1, 0xab, 0x24, 0xbd, 0x6b, 0xe7, 0xe9, 0x66, 0x75, 0xe3, 0xc8, 0x8e, 0x77, 0xdb,
-	0xc6, 0x0f, 0xbd, 0xe8, 0xa6, 0x45, 0x92, 0x0f, 0x2d, 0x7e, 0x07, 0x06, 0x4e, 0x87, 0xdf, 0xaf,
-	0xe3, 0xe0, 0xe7, 0x75, 0x1c, 0x8c, 0xcf, 0x57, 0x7f, 0x59, 0xb0, 0xea, 0x58, 0x78, 0xd3, 0xb1,
-	0xf0, 0x4f, 0xc7, 0xc2, 0x1f, 0x6b, 0x16, 0xdc, 0xac, 0x59, 0xf0, 0x6b, 0xcd, 0x82, 0x2f, 0x6f,
-	0xfe, 0xb3, 0xe8, 0x95, 0x71, 0x36, 0x13, 0x13, 0x01, 0xf3, 0xac, 0xc4, 0xe3, 0xcd, 0xa5, 0x2c,
-	0x77, 0xb7, 0xe2, 0x6c, 0x17, 0x07, 0xee, 0x47, 0x5f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x08,
-	0x4f, 0x41, 0xe8, 0x4c, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xb5, 0x9b, 0x36, 0x24, 0x53, 0xf1, 0x1a, 0x55, 0x91, 0x89, 0x90, 0xa7, 0xf2, 0x02, 0xba,
+	0xa9, 0xad, 0x82, 0x04, 0x52, 0x77, 0xb8, 0x08, 0xa9, 0x0b, 0x36, 0xa6, 0x2c, 0x60, 0x13, 0x8d,
+	0xe3, 0x89, 0x6b, 0xd5, 0xe3, 0x6b, 0x79, 0x26, 0x28, 0xfe, 0x03, 0x96, 0x2c, 0x59, 0xf6, 0x73,
+	0xb2, 0xec, 0x12, 0xb1, 0xb0, 0x20, 0xf9, 0x83, 0xfc, 0x00, 0x68, 0xc6, 0x93, 0x07, 0x0b, 0x56,
+	0x73, 0xee, 0x99, 0x7b, 0xee, 0xe3, 0xe8, 0xa2, 0xe7, 0x71, 0x26, 0x05, 0x14, 0x69, 0x30, 0xa1,
+	0x85, 0x84, 0x1b, 0x56, 0x04, 0x5f, 0xce, 0x62, 0x26, 0xe9, 0xd9, 0x86, 0xf0, 0xcb, 0x0a, 0x24,
+	0x60, 0xc7, 0x24, 0xfa, 0x1b, 0xde, 0x24, 0x0e, 0xdd, 0x31, 0x08, 0x0e, 0x22, 0x88, 0xa9, 0x60,
+	0x1b, 0xf5, 0x18, 0x32, 0xa3, 0x1c, 0x1e, 0xa5, 0x90, 0x82, 0x86, 0x81, 0x42, 0x2d, 0xeb, 0x01,
+	0xea, 0xbd, 0x67, 0x92, 0x26, 0x54, 0x52, 0x8c, 0xd1, 0x7e, 0x41, 0x39, 0x73, 0xec, 0x63, 0xfb,
+	0xa4, 0x1f, 0x69, 0x8c, 0x07, 0xa8, 0x2b, 0x6a, 0x1e, 0x43, 0xee, 0xec, 0x69, 0xd6, 0x44, 0xf8,
+	0x09, 0xea, 0x4c, 0xab, 0xcc, 0xe9, 0x28, 0x32, 0xbc, 0xb7, 0x68, 0x48, 0xe7, 0x63, 0x74, 0x19,
+	0x29, 0x0e, 0x3f, 0x45, 0x7d, 0x3a, 0x95, 0xd7, 0x50, 0x65, 0xb2, 0x76, 0xf6, 0xb5, 0x6a, 0x4b,
+	0x78, 0x7f, 0xf6, 0x50, 0xef, 0x1d, 0x2d, 0xae, 0xd4, 0xec, 0xf8, 0x08, 0x1d, 0x24, 0xac, 0x00,
+	0x6e, 0x5a, 0xb6, 0x01, 0x8e, 0x11, 0xe2, 0x74, 0x36, 0x12, 0xd3, 0xb2, 0xcc, 0xeb, 0xb6, 0x6f,
+	0x78, 0x31, 0x6f, 0x88, 0xf5, 0xb3, 0x21, 0xcf, 0xd2, 0x4c, 0x5e, 0x4f, 0x63, 0x7f, 0x0c, 0x3c,
+	0x30, 0x0b, 0xb7, 0xcf, 0xa9, 0x48, 0x6e, 0x02, 0x59, 0x97, 0x4c, 0xf8, 0x97, 0x85, 0x5c, 0x35,
+	0xe4, 0x71, 0x4d, 0x79, 0x7e, 0xee, 0x6d, 0x2b, 0x79, 0x51, 0x9f, 0xd3, 0xd9, 0x07, 0x8d, 0xd5,
+	0x5e, 0x3c, 0x2b, 0x24, 0xab, 0xda, 0x15, 0x22, 0x13, 0xe1, 0x4f, 0xa8, 0xcf, 0x99, 0xa4, 0x23,
+	0x65, 0x88, 0x1e, 0xfe, 0xf0, 0x85, 0xe7, 0xff, 0xcf, 0x73, 0x7f, 0x6d, 0x5d, 0xe8, 0xa8, 0xf1,
+	0x56, 0x0d, 0x79, 0x64, 0x9a, 0xae, 0x4b, 0x78, 0x51, 0x4f, 0xe1, 0xb7, 0xca, 0xde, 0xd7, 0xe8,
+	0xb0, 0x82, 0x9a, 0xe6, 0xb2, 0x1e, 0xc5, 0xa5, 0x70, 0x0e, 0x8e, 0xed, 0x93, 0xfb, 0xe1, 0x60,
+	0xd5, 0x10, 0xdc, 0x8a, 0x76, 0x3e, 0xbd, 0x08, 0x99, 0x28, 0x2c, 0x05, 0xbe, 0x40, 0x0f, 0xd7,
+	0x7f, 0x34, 0x49, 0x2a, 0x26, 0x84, 0xd3, 0xd5, 0xa6, 0x0c, 0x57, 0x0d, 0x19, 0xfc, 0x2b, 0x36,
+	0x09, 0x5e, 0xf4, 0xc0, 0x30, 0x6f, 0x5a, 0xe2, 0xbc, 0xf7, 0xf5, 0x96, 0x58, 0xdf, 0x6f, 0x89,
+	0x15, 0x5e, 0xcd, 0x7f, 0xbb, 0xd6, 0x7c, 0xe1, 0xda, 0x77, 0x0b, 0xd7, 0xfe, 0xb5, 0x70, 0xed,
+	0x6f, 0x4b, 0xd7, 0xba, 0x5b, 0xba, 0xd6, 0x8f, 0xa5, 0x6b, 0x7d, 0x7e, 0xb5, 0x63, 0xb0, 0xd9,
+	0x1b, 0x26, 0x93, 0x6c, 0x9c, 0xd1, 0x3c, 0x48, 0xe1, 0x74, 0x7d, 0xa7, 0xb3, 0xed, 0xa5, 0x6a,
+	0xd3, 0xe3, 0xae, 0xbe, 0xa7, 0x97, 0x7f, 0x07, 0x00, 0x6f, 0x61, 0x8d, 0x38, 0xca, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -222,6 +231,18 @@ func (m *FanToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoyaltyAddress) > 0 {
+		i -= len(m.RoyaltyAddress)
+		copy(dAtA[i:], m.RoyaltyAddress)
+		i = encodeVarintFantoken(dAtA, i, uint64(len(m.RoyaltyAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RoyaltyBps != 0 {
+		i = encodeVarintFantoken(dAtA, i, uint64(m.RoyaltyBps))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -313,6 +334,13 @@ func (m *FanToken) Size() (n int) {
 	}
 	l = m.MetaData.Size()
 	n += 1 + l + sovFantoken(uint64(l))
+	if m.RoyaltyBps != 0 {
+		n += 1 + sovFantoken(uint64(m.RoyaltyBps))
+	}
+	l = len(m.RoyaltyAddress)
+	if l > 0 {
+		n += 1 + l + sovFantoken(uint64(l))
+	}
 	return n
 }
 
@@ -660,6 +688,57 @@ func (m *FanToken) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBps", wireType)
+			}
+			m.RoyaltyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFantoken(dAtA[iNdEx:])
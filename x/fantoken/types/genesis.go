@@ -1,10 +1,17 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesisState returns the default genesis state for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:    DefaultParams(),
-		FanTokens: []FanToken{},
+		Params:          DefaultParams(),
+		FanTokens:       []FanToken{},
+		PausedDenoms:    []string{},
+		MintSchedules:   []MintSchedule{},
+		VerifiedSymbols: []VerifiedSymbol{},
 	}
 }
 
@@ -24,10 +31,59 @@ func (gs GenesisState) Validate() error {
 	}
 
 	// validate fantoken
+	denoms := make(map[string]bool, len(gs.FanTokens))
+	symbols := make(map[string]string, len(gs.FanTokens))
 	for _, fantoken := range gs.FanTokens {
 		if err := fantoken.ValidateWithDenom(); err != nil {
 			return err
 		}
+		denoms[fantoken.GetDenom()] = true
+		symbols[fantoken.GetDenom()] = fantoken.GetSymbol()
+	}
+
+	// validate paused denoms
+	for _, denom := range gs.PausedDenoms {
+		if !denoms[denom] {
+			return sdkerrors.Wrapf(ErrFanTokenNotExists, "paused fantoken not found: %s", denom)
+		}
+	}
+
+	// validate mint schedules
+	ids := make(map[uint64]bool, len(gs.MintSchedules))
+	for _, schedule := range gs.MintSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if !denoms[schedule.Denom] {
+			return sdkerrors.Wrapf(ErrFanTokenNotExists, "mint schedule fantoken not found: %s", schedule.Denom)
+		}
+
+		if ids[schedule.Id] || schedule.Id == 0 || schedule.Id > gs.LastMintScheduleId {
+			return sdkerrors.Wrapf(ErrInvalidMintSchedule, "invalid mint schedule id %d", schedule.Id)
+		}
+		ids[schedule.Id] = true
+	}
+
+	// validate verified symbols
+	verified := make(map[string]bool, len(gs.VerifiedSymbols))
+	for _, vs := range gs.VerifiedSymbols {
+		if err := vs.Validate(); err != nil {
+			return err
+		}
+
+		if !denoms[vs.Denom] {
+			return sdkerrors.Wrapf(ErrFanTokenNotExists, "verified fantoken not found: %s", vs.Denom)
+		}
+
+		if symbols[vs.Denom] != vs.Symbol {
+			return sdkerrors.Wrapf(ErrInvalidSymbol, "the symbol of the fantoken %s is not %s", vs.Denom, vs.Symbol)
+		}
+
+		if verified[vs.Symbol] {
+			return sdkerrors.Wrapf(ErrSymbolReserved, "duplicated verified symbol %s", vs.Symbol)
+		}
+		verified[vs.Symbol] = true
 	}
 
 	return nil
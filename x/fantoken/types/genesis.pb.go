@@ -28,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FanTokens []FanToken `protobuf:"bytes,2,rep,name=fan_tokens,json=fanTokens,proto3" json:"fan_tokens"`
+	// paused_denoms defines the denoms of the fantokens with paused transfers
+	PausedDenoms       []string       `protobuf:"bytes,3,rep,name=paused_denoms,json=pausedDenoms,proto3" json:"paused_denoms,omitempty" yaml:"paused_denoms"`
+	MintSchedules      []MintSchedule `protobuf:"bytes,4,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules" yaml:"mint_schedules"`
+	LastMintScheduleId uint64         `protobuf:"varint,5,opt,name=last_mint_schedule_id,json=lastMintScheduleId,proto3" json:"last_mint_schedule_id,omitempty" yaml:"last_mint_schedule_id"`
+	// verified_symbols defines the symbols reserved to a fantoken
+	VerifiedSymbols []VerifiedSymbol `protobuf:"bytes,6,rep,name=verified_symbols,json=verifiedSymbols,proto3" json:"verified_symbols" yaml:"verified_symbols"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,8 +83,90 @@ func (m *GenesisState) GetFanTokens() []FanToken {
 	return nil
 }
 
+func (m *GenesisState) GetPausedDenoms() []string {
+	if m != nil {
+		return m.PausedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintSchedules() []MintSchedule {
+	if m != nil {
+		return m.MintSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastMintScheduleId() uint64 {
+	if m != nil {
+		return m.LastMintScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetVerifiedSymbols() []VerifiedSymbol {
+	if m != nil {
+		return m.VerifiedSymbols
+	}
+	return nil
+}
+
+// VerifiedSymbol binds a symbol to the denom of the verified fantoken
+type VerifiedSymbol struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *VerifiedSymbol) Reset()         { *m = VerifiedSymbol{} }
+func (m *VerifiedSymbol) String() string { return proto.CompactTextString(m) }
+func (*VerifiedSymbol) ProtoMessage()    {}
+func (*VerifiedSymbol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a9d02535fd9f192, []int{1}
+}
+func (m *VerifiedSymbol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiedSymbol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiedSymbol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifiedSymbol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedSymbol.Merge(m, src)
+}
+func (m *VerifiedSymbol) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiedSymbol) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedSymbol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiedSymbol proto.InternalMessageInfo
+
+func (m *VerifiedSymbol) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *VerifiedSymbol) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "bitsong.fantoken.v1beta1.GenesisState")
+	proto.RegisterType((*VerifiedSymbol)(nil), "bitsong.fantoken.v1beta1.VerifiedSymbol")
 }
 
 func init() {
@@ -86,25 +174,37 @@ func init() {
 }
 
 var fileDescriptor_3a9d02535fd9f192 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x80, 0xe1, 0x18, 0x50, 0x25, 0x52, 0xa6, 0x88, 0x21, 0xca, 0x60, 0xa2, 0x4a, 0x40, 0x17,
-	0x6c, 0xb5, 0x48, 0x8c, 0x0c, 0x1d, 0xe8, 0x5a, 0x01, 0x13, 0x0b, 0xb2, 0x23, 0xdb, 0x58, 0x10,
-	0x5f, 0xd4, 0x33, 0x08, 0xde, 0x82, 0x8d, 0x57, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xe4, 0x45, 0x50,
-	0x13, 0xb7, 0x4c, 0xd9, 0x92, 0xe8, 0xcb, 0x6f, 0xdf, 0xc5, 0x67, 0xd2, 0x7a, 0x04, 0x67, 0xb8,
-	0x16, 0xce, 0xc3, 0xb3, 0x72, 0xfc, 0x6d, 0x22, 0x95, 0x17, 0x13, 0x6e, 0x94, 0x53, 0x68, 0x91,
-	0x55, 0x4b, 0xf0, 0x90, 0xa4, 0xc1, 0xb1, 0xad, 0x63, 0xc1, 0x65, 0xc7, 0x06, 0x0c, 0xb4, 0x88,
-	0x6f, 0x9e, 0x3a, 0x9f, 0x9d, 0xf7, 0x76, 0x77, 0x81, 0x0e, 0x9e, 0xf6, 0xc2, 0x4a, 0x2c, 0x45,
-	0x19, 0xce, 0xcf, 0x68, 0x01, 0x58, 0x02, 0x72, 0x29, 0x50, 0xed, 0x44, 0x01, 0x36, 0x64, 0x46,
-	0x5f, 0x24, 0x3e, 0x9a, 0x77, 0x37, 0xbe, 0xf3, 0xc2, 0xab, 0xe4, 0x3a, 0x1e, 0x74, 0x81, 0x94,
-	0xe4, 0x64, 0x3c, 0x9c, 0xe6, 0xac, 0x6f, 0x02, 0xb6, 0x68, 0xdd, 0xec, 0x60, 0xf5, 0x73, 0x12,
-	0xdd, 0x86, 0xbf, 0x92, 0x79, 0x1c, 0x6b, 0xe1, 0x1e, 0x5b, 0x89, 0xe9, 0x5e, 0xbe, 0x3f, 0x1e,
-	0x4e, 0x47, 0xfd, 0x8d, 0x1b, 0xe1, 0xee, 0x37, 0x1f, 0x42, 0xe5, 0x50, 0x87, 0x77, 0x9c, 0x2d,
-	0x56, 0x35, 0x25, 0xeb, 0x9a, 0x92, 0xdf, 0x9a, 0x92, 0xcf, 0x86, 0x46, 0xeb, 0x86, 0x46, 0xdf,
-	0x0d, 0x8d, 0x1e, 0xae, 0x8c, 0xf5, 0x4f, 0xaf, 0x92, 0x15, 0x50, 0xf2, 0x10, 0x06, 0xad, 0x6d,
-	0x61, 0xc5, 0x0b, 0x37, 0x70, 0xb1, 0x5d, 0xcc, 0xfb, 0xff, 0x6a, 0xfc, 0x47, 0xa5, 0x50, 0x0e,
-	0xda, 0x91, 0x2f, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xbd, 0x7c, 0xfe, 0xbc, 0x01, 0x00,
-	0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x26, 0x8d, 0x94, 0xed, 0x1f, 0xd0, 0x2a, 0x05, 0x2b, 0x02, 0xc7, 0xb2, 0x44,
+	0xf1, 0x01, 0x6c, 0xb5, 0x48, 0x1c, 0x90, 0xe8, 0xc1, 0x42, 0x54, 0x1c, 0x90, 0x2a, 0x07, 0x71,
+	0xe0, 0x62, 0xad, 0xed, 0xb5, 0xbb, 0xc2, 0xde, 0x8d, 0x32, 0x9b, 0x88, 0x3c, 0x05, 0x3c, 0x56,
+	0x8f, 0x3d, 0x72, 0x8a, 0x50, 0xf2, 0x06, 0x79, 0x02, 0x94, 0xdd, 0xa5, 0xd4, 0x08, 0xdf, 0x3c,
+	0xe3, 0xdf, 0x7c, 0xf3, 0x7d, 0xab, 0x41, 0xa7, 0x29, 0x93, 0x20, 0x78, 0x19, 0x16, 0x84, 0x4b,
+	0xf1, 0x95, 0xf2, 0x70, 0x71, 0x96, 0x52, 0x49, 0xce, 0xc2, 0x92, 0x72, 0x0a, 0x0c, 0x82, 0xe9,
+	0x4c, 0x48, 0x81, 0x6d, 0xc3, 0x05, 0x7f, 0xb8, 0xc0, 0x70, 0xa3, 0x61, 0x29, 0x4a, 0xa1, 0xa0,
+	0x70, 0xf7, 0xa5, 0xf9, 0xd1, 0xf3, 0x56, 0xdd, 0x3b, 0x01, 0x0d, 0xbe, 0x68, 0x05, 0x6b, 0xc6,
+	0x65, 0x02, 0xd9, 0x35, 0xcd, 0xe7, 0x15, 0x35, 0xf4, 0xb3, 0x56, 0x7a, 0x4a, 0x66, 0xa4, 0x36,
+	0x6e, 0x47, 0x4e, 0x26, 0xa0, 0x16, 0x10, 0xa6, 0x04, 0xe8, 0x1d, 0x91, 0x09, 0x66, 0x96, 0x7a,
+	0xdf, 0x7b, 0xe8, 0xf0, 0x52, 0xe7, 0x9b, 0x48, 0x22, 0x29, 0xbe, 0x40, 0x7d, 0x2d, 0x60, 0x5b,
+	0xae, 0xe5, 0x1f, 0x9c, 0xbb, 0x41, 0x5b, 0xde, 0xe0, 0x4a, 0x71, 0x51, 0xef, 0x66, 0x35, 0xee,
+	0xc4, 0x66, 0x0a, 0x5f, 0x22, 0x54, 0x10, 0x9e, 0x28, 0x12, 0xec, 0x3d, 0xb7, 0xeb, 0x1f, 0x9c,
+	0x7b, 0xed, 0x1a, 0xef, 0x09, 0xff, 0xb4, 0x6b, 0x18, 0x95, 0x41, 0x61, 0x6a, 0xc0, 0x6f, 0xd1,
+	0xd1, 0x94, 0xcc, 0x81, 0xe6, 0x49, 0x4e, 0xb9, 0xa8, 0xc1, 0xee, 0xba, 0x5d, 0x7f, 0x10, 0xd9,
+	0xdb, 0xd5, 0x78, 0xb8, 0x24, 0x75, 0xf5, 0xc6, 0x6b, 0xfc, 0xf6, 0xe2, 0x43, 0x5d, 0xbf, 0x53,
+	0x25, 0xae, 0xd0, 0x71, 0xe3, 0xd9, 0xc0, 0xee, 0x29, 0x2f, 0xa7, 0xed, 0x5e, 0x3e, 0x32, 0x2e,
+	0x27, 0x06, 0x8f, 0x9e, 0xee, 0xfc, 0x6c, 0x57, 0xe3, 0x13, 0xbd, 0xab, 0xa9, 0xe5, 0xc5, 0x47,
+	0xf5, 0x3d, 0x18, 0xf0, 0x04, 0x9d, 0x54, 0x04, 0x64, 0xd2, 0xc0, 0x12, 0x96, 0xdb, 0xfb, 0xae,
+	0xe5, 0xf7, 0x22, 0x77, 0xbb, 0x1a, 0x3f, 0xd1, 0x42, 0xff, 0xc5, 0xbc, 0x18, 0xef, 0xfa, 0xf7,
+	0x0d, 0x7c, 0xc8, 0xb1, 0x44, 0x0f, 0x17, 0x74, 0xc6, 0x0a, 0x46, 0xf3, 0x04, 0x96, 0x75, 0x2a,
+	0x2a, 0xb0, 0xfb, 0x2a, 0x84, 0xdf, 0x1e, 0xe2, 0xb3, 0x99, 0x98, 0xa8, 0x81, 0x68, 0x6c, 0x62,
+	0x3c, 0xd6, 0xdb, 0xff, 0xd5, 0xf3, 0xe2, 0x07, 0x8b, 0xc6, 0x00, 0x78, 0x17, 0xe8, 0xb8, 0xa9,
+	0x81, 0x1f, 0xa1, 0xbe, 0xc6, 0xd5, 0x49, 0x0c, 0x62, 0x53, 0xe1, 0x21, 0xda, 0x57, 0x6f, 0x6f,
+	0xef, 0xa9, 0xb6, 0x2e, 0xa2, 0xab, 0x9b, 0xb5, 0x63, 0xdd, 0xae, 0x1d, 0xeb, 0xd7, 0xda, 0xb1,
+	0x7e, 0x6c, 0x9c, 0xce, 0xed, 0xc6, 0xe9, 0xfc, 0xdc, 0x38, 0x9d, 0x2f, 0xaf, 0x4b, 0x26, 0xaf,
+	0xe7, 0x69, 0x90, 0x89, 0x3a, 0x34, 0xfe, 0x45, 0x51, 0xb0, 0x8c, 0x91, 0x2a, 0x2c, 0xc5, 0x4b,
+	0xd3, 0x0a, 0xbf, 0xfd, 0x3d, 0x69, 0xb9, 0x9c, 0x52, 0x48, 0xfb, 0xea, 0x54, 0x5f, 0xfd, 0x1e,
+	0x00, 0x49, 0x6c, 0x72, 0x71, 0xa2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +227,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VerifiedSymbols) > 0 {
+		for iNdEx := len(m.VerifiedSymbols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifiedSymbols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastMintScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastMintScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MintSchedules) > 0 {
+		for iNdEx := len(m.MintSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PausedDenoms) > 0 {
+		for iNdEx := len(m.PausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedDenoms[iNdEx])
+			copy(dAtA[i:], m.PausedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FanTokens) > 0 {
 		for iNdEx := len(m.FanTokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +296,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VerifiedSymbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedSymbol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedSymbol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +358,44 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PausedDenoms) > 0 {
+		for _, s := range m.PausedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintSchedules) > 0 {
+		for _, e := range m.MintSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastMintScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastMintScheduleId))
+	}
+	if len(m.VerifiedSymbols) > 0 {
+		for _, e := range m.VerifiedSymbols {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifiedSymbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -284,6 +501,239 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenoms = append(m.PausedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedules = append(m.MintSchedules, MintSchedule{})
+			if err := m.MintSchedules[len(m.MintSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintScheduleId", wireType)
+			}
+			m.LastMintScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMintScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedSymbols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedSymbols = append(m.VerifiedSymbols, VerifiedSymbol{})
+			if err := m.VerifiedSymbols[len(m.VerifiedSymbols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedSymbol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedSymbol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedSymbol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
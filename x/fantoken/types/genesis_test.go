@@ -2,11 +2,27 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"testing"
+	"time"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fantokens := []FanToken{
+		{
+			Denom:     "fttest",
+			MaxSupply: sdk.NewInt(100),
+			MetaData: Metadata{
+				Name:   "test token",
+				Symbol: "fttest",
+			},
+		},
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *GenesisState
@@ -21,9 +37,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -43,9 +59,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "empty authority",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -65,9 +81,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "issue fee 0",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -87,9 +103,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "no fantokens",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: nil,
 			},
@@ -99,9 +115,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "no metadata",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -117,9 +133,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "invalid symbol",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -138,9 +154,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "empty name",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -159,9 +175,9 @@ func TestValidateGenesis(t *testing.T) {
 			desc: "empty uri",
 			genState: &GenesisState{
 				Params: Params{
-					IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0)),
-					MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-					BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+					IssueFee: []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(0))},
+					MintFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+					BurnFee:  []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
 				},
 				FanTokens: []FanToken{
 					{
@@ -177,6 +193,170 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid memo channel",
+			genState: &GenesisState{
+				Params: NewParams(nil, nil, nil, 0, []string{"channel-0", "chan"}),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate memo channel",
+			genState: &GenesisState{
+				Params: NewParams(nil, nil, nil, 0, []string{"channel-0", "channel-0"}),
+			},
+			valid: false,
+		},
+		{
+			desc: "royalty above 100%",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FanTokens: []FanToken{
+					{
+						Denom:     "fttest",
+						MaxSupply: sdk.NewInt(1),
+						MetaData: Metadata{
+							Name:   "test token",
+							Symbol: "fttest",
+						},
+						RoyaltyBps: MaxBasisPoints + 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "royalty paid to a module account",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FanTokens: []FanToken{
+					{
+						Denom:     "fttest",
+						MaxSupply: sdk.NewInt(1),
+						MetaData: Metadata{
+							Name:   "test token",
+							Symbol: "fttest",
+						},
+						RoyaltyBps:     500,
+						RoyaltyAddress: authtypes.NewModuleAddress(ModuleName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "paused fantoken",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FanTokens: []FanToken{
+					{
+						Denom:     "fttest",
+						MaxSupply: sdk.NewInt(1),
+						MetaData: Metadata{
+							Name:   "test token",
+							Symbol: "fttest",
+						},
+					},
+				},
+				PausedDenoms: []string{"fttest"},
+			},
+			valid: true,
+		},
+		{
+			desc: "paused unknown fantoken",
+			genState: &GenesisState{
+				Params:       DefaultParams(),
+				FanTokens:    nil,
+				PausedDenoms: []string{"fttest"},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint schedule",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				FanTokens: fantokens,
+				MintSchedules: []MintSchedule{
+					NewMintSchedule(1, recipient, sdk.NewCoin("fttest", sdk.NewInt(10)), ScheduleTypeLinear, startTime, startTime.Add(time.Hour)),
+				},
+				LastMintScheduleId: 1,
+			},
+			valid: true,
+		},
+		{
+			desc: "mint schedule id greater than the last id",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				FanTokens: fantokens,
+				MintSchedules: []MintSchedule{
+					NewMintSchedule(2, recipient, sdk.NewCoin("fttest", sdk.NewInt(10)), ScheduleTypeLinear, startTime, startTime.Add(time.Hour)),
+				},
+				LastMintScheduleId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "mint schedule of an unknown fantoken",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				MintSchedules: []MintSchedule{
+					NewMintSchedule(1, recipient, sdk.NewCoin("ftunknown", sdk.NewInt(10)), ScheduleTypeLinear, startTime, startTime.Add(time.Hour)),
+				},
+				LastMintScheduleId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "linear mint schedule without duration",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				FanTokens: fantokens,
+				MintSchedules: []MintSchedule{
+					NewMintSchedule(1, recipient, sdk.NewCoin("fttest", sdk.NewInt(10)), ScheduleTypeLinear, startTime, startTime),
+				},
+				LastMintScheduleId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "verified symbol",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FanTokens:       fantokens,
+				VerifiedSymbols: []VerifiedSymbol{{Symbol: "fttest", Denom: "fttest"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "verified symbol of an unknown fantoken",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FanTokens:       fantokens,
+				VerifiedSymbols: []VerifiedSymbol{{Symbol: "fttest", Denom: "ftunknown"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "verified symbol different from the fantoken symbol",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FanTokens:       fantokens,
+				VerifiedSymbols: []VerifiedSymbol{{Symbol: "test", Denom: "fttest"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated verified symbol",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				FanTokens: fantokens,
+				VerifiedSymbols: []VerifiedSymbol{
+					{Symbol: "fttest", Denom: "fttest"},
+					{Symbol: "fttest", Denom: "fttest"},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -2,21 +2,37 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"strings"
+
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 )
 
-const ProposalTypeUpdateFees = "UpdateFantokenFeesProposal"
+const (
+	ProposalTypeUpdateFees             = "UpdateFantokenFeesProposal"
+	ProposalTypeRegisterVerifiedSymbol = "RegisterVerifiedSymbolProposal"
+	ProposalTypeUpdateParams           = "UpdateFantokenParamsProposal"
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdateFees)
 	govtypes.RegisterProposalTypeCodec(&UpdateFeesProposal{}, "go-bitsong/fantoken/UpdateFeesProposal")
+	govtypes.RegisterProposalType(ProposalTypeRegisterVerifiedSymbol)
+	govtypes.RegisterProposalTypeCodec(&RegisterVerifiedSymbolProposal{}, "go-bitsong/fantoken/RegisterVerifiedSymbolProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "go-bitsong/fantoken/UpdateParamsProposal")
 }
 
-var _ govtypes.Content = &UpdateFeesProposal{}
+var (
+	_ govtypes.Content = &UpdateFeesProposal{}
+	_ govtypes.Content = &RegisterVerifiedSymbolProposal{}
+	_ govtypes.Content = &UpdateParamsProposal{}
+)
 
-func NewUpdateFeesProposal(title, description string, issueFee, mintFee, burnFee sdk.Coin) govtypes.Content {
+// NewUpdateFeesProposal creates a legacy fees proposal.
+func NewUpdateFeesProposal(title, description string, issueFee, mintFee, burnFee []sdk.Coin) govtypes.Content {
 	return &UpdateFeesProposal{
 		Title:       title,
 		Description: description,
@@ -55,6 +71,91 @@ func (p UpdateFeesProposal) String() string {
   Issue Fee:   %s
   Mint Fee:    %s
   Burn Fee:    %s
-`, p.Title, p.Description, p.IssueFee, p.MintFee, p.BurnFee))
+`, p.Title, p.Description, feestypes.FeesString(p.IssueFee), feestypes.FeesString(p.MintFee), feestypes.FeesString(p.BurnFee)))
+	return b.String()
+}
+
+func NewRegisterVerifiedSymbolProposal(title, description, symbol, denom string) govtypes.Content {
+	return &RegisterVerifiedSymbolProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Denom:       denom,
+	}
+}
+
+func (p *RegisterVerifiedSymbolProposal) GetTitle() string { return p.Title }
+
+func (p *RegisterVerifiedSymbolProposal) GetDescription() string { return p.Description }
+
+func (p *RegisterVerifiedSymbolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RegisterVerifiedSymbolProposal) ProposalType() string {
+	return ProposalTypeRegisterVerifiedSymbol
+}
+
+func (p *RegisterVerifiedSymbolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return VerifiedSymbol{Symbol: p.Symbol, Denom: p.Denom}.Validate()
+}
+
+func (p RegisterVerifiedSymbolProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Register Verified Symbol Proposal:
+  Title:       %s
+  Description: %s
+  Symbol:      %s
+  Denom:       %s
+`, p.Title, p.Description, p.Symbol, p.Denom))
+	return b.String()
+}
+
+// NewUpdateParamsProposal creates a legacy proposal replacing all the params
+func NewUpdateParamsProposal(title, description string, params Params) govtypes.Content {
+	return &UpdateParamsProposal{
+		Title:       title,
+		Description: description,
+		Params:      params,
+	}
+}
+
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return p.Params.Validate()
+}
+
+func (p UpdateParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Fantoken Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params.String()))
 	return b.String()
 }
+
+// Validate checks the symbol and the denom of the verified symbol
+func (vs VerifiedSymbol) Validate() error {
+	if err := ValidateSymbol(vs.Symbol); err != nil {
+		return err
+	}
+
+	return ValidateDenom(vs.Denom)
+}
@@ -24,12 +24,13 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpdateFeesProposal updates the fees from a legacy governance proposal.
 type UpdateFeesProposal struct {
-	Title       string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	IssueFee    types.Coin `protobuf:"bytes,3,opt,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
-	MintFee     types.Coin `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
-	BurnFee     types.Coin `protobuf:"bytes,5,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee" yaml:"burn_fee"`
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IssueFee    []types.Coin `protobuf:"bytes,3,rep,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
+	MintFee     []types.Coin `protobuf:"bytes,4,rep,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+	BurnFee     []types.Coin `protobuf:"bytes,5,rep,name=burn_fee,json=burnFee,proto3" json:"burn_fee" yaml:"burn_fee"`
 }
 
 func (m *UpdateFeesProposal) Reset()      { *m = UpdateFeesProposal{} }
@@ -106,9 +107,179 @@ func (m *UpdateFeesProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateFeesProposalWithDeposit proto.InternalMessageInfo
 
+// RegisterVerifiedSymbolProposal reserves a symbol to a fantoken: the symbol
+// cannot be used anymore by the other issuers
+type RegisterVerifiedSymbolProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Denom       string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RegisterVerifiedSymbolProposal) Reset()      { *m = RegisterVerifiedSymbolProposal{} }
+func (*RegisterVerifiedSymbolProposal) ProtoMessage() {}
+func (*RegisterVerifiedSymbolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1525a26433a8d1c3, []int{2}
+}
+func (m *RegisterVerifiedSymbolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterVerifiedSymbolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterVerifiedSymbolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterVerifiedSymbolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterVerifiedSymbolProposal.Merge(m, src)
+}
+func (m *RegisterVerifiedSymbolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterVerifiedSymbolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterVerifiedSymbolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterVerifiedSymbolProposal proto.InternalMessageInfo
+
+type RegisterVerifiedSymbolProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Denom       string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *RegisterVerifiedSymbolProposalWithDeposit) Reset() {
+	*m = RegisterVerifiedSymbolProposalWithDeposit{}
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RegisterVerifiedSymbolProposalWithDeposit) ProtoMessage() {}
+func (*RegisterVerifiedSymbolProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1525a26433a8d1c3, []int{3}
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterVerifiedSymbolProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterVerifiedSymbolProposalWithDeposit.Merge(m, src)
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterVerifiedSymbolProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterVerifiedSymbolProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterVerifiedSymbolProposalWithDeposit proto.InternalMessageInfo
+
+// UpdateParamsProposal replaces all the params at once from a legacy governance
+// proposal, executing the MsgUpdateParams with the gov module as authority
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1525a26433a8d1c3, []int{4}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+type UpdateParamsProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *UpdateParamsProposalWithDeposit) Reset()         { *m = UpdateParamsProposalWithDeposit{} }
+func (m *UpdateParamsProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsProposalWithDeposit) ProtoMessage()    {}
+func (*UpdateParamsProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1525a26433a8d1c3, []int{5}
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposalWithDeposit.Merge(m, src)
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposalWithDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UpdateFeesProposal)(nil), "bitsong.fantoken.v1beta1.UpdateFeesProposal")
 	proto.RegisterType((*UpdateFeesProposalWithDeposit)(nil), "bitsong.fantoken.v1beta1.UpdateFeesProposalWithDeposit")
+	proto.RegisterType((*RegisterVerifiedSymbolProposal)(nil), "bitsong.fantoken.v1beta1.RegisterVerifiedSymbolProposal")
+	proto.RegisterType((*RegisterVerifiedSymbolProposalWithDeposit)(nil), "bitsong.fantoken.v1beta1.RegisterVerifiedSymbolProposalWithDeposit")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "bitsong.fantoken.v1beta1.UpdateParamsProposal")
+	proto.RegisterType((*UpdateParamsProposalWithDeposit)(nil), "bitsong.fantoken.v1beta1.UpdateParamsProposalWithDeposit")
 }
 
 func init() {
@@ -116,33 +287,41 @@ func init() {
 }
 
 var fileDescriptor_1525a26433a8d1c3 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
-	0x14, 0xcd, 0x3c, 0xdf, 0x33, 0xcd, 0xbc, 0x85, 0x12, 0x1e, 0x98, 0x3e, 0x71, 0x52, 0xb2, 0x7a,
-	0x1b, 0x13, 0xaa, 0xe0, 0xa2, 0xcb, 0x2a, 0xdd, 0x09, 0x25, 0x28, 0x82, 0x1b, 0x49, 0xd2, 0x9b,
-	0x74, 0x30, 0x99, 0x1b, 0x32, 0xd3, 0x62, 0xff, 0x85, 0xcb, 0x2e, 0xfb, 0x6b, 0xa4, 0xb8, 0xea,
-	0xd2, 0x55, 0xd1, 0x76, 0xe3, 0xda, 0x5f, 0x20, 0xf9, 0x6a, 0x4b, 0x5d, 0x88, 0xee, 0xce, 0xcc,
-	0xbd, 0xe7, 0x70, 0xee, 0xe1, 0x50, 0x27, 0xe4, 0x4a, 0xa2, 0x48, 0xbc, 0x38, 0x10, 0x0a, 0x3f,
-	0x82, 0xf0, 0xe6, 0xfd, 0x10, 0x54, 0xd0, 0xf7, 0x12, 0x9c, 0xbb, 0x79, 0x81, 0x0a, 0x4d, 0xab,
-	0xd9, 0x71, 0xdb, 0x1d, 0xb7, 0xd9, 0xb9, 0x65, 0x11, 0xca, 0x0c, 0xa5, 0x17, 0x06, 0x12, 0x0e,
-	0xc4, 0x08, 0xb9, 0xa8, 0x99, 0xb7, 0x37, 0x09, 0x26, 0x58, 0x41, 0xaf, 0x44, 0xf5, 0xaf, 0xf3,
-	0xe5, 0x82, 0x9a, 0x6f, 0xf3, 0x49, 0xa0, 0x60, 0x04, 0x20, 0xc7, 0x05, 0xe6, 0x28, 0x83, 0xd4,
-	0xbc, 0xa1, 0x57, 0x8a, 0xab, 0x14, 0x2c, 0xd2, 0x23, 0x77, 0x86, 0x5f, 0x3f, 0xcc, 0x1e, 0xbd,
-	0x9e, 0x80, 0x8c, 0x0a, 0x9e, 0x2b, 0x8e, 0xc2, 0xba, 0xa8, 0x66, 0xa7, 0x5f, 0xe6, 0x98, 0x1a,
-	0x5c, 0xca, 0x19, 0x7c, 0x88, 0x01, 0xac, 0x7b, 0x3d, 0x72, 0x77, 0xfd, 0xac, 0xeb, 0xd6, 0xc6,
-	0xdc, 0xd2, 0x58, 0xeb, 0xd6, 0x7d, 0x89, 0x5c, 0x0c, 0xad, 0xf5, 0xd6, 0xd6, 0x7e, 0x6d, 0xed,
-	0x87, 0x8b, 0x20, 0x4b, 0x07, 0xce, 0x81, 0xe9, 0xf8, 0x9d, 0x0a, 0x8f, 0x00, 0xcc, 0xd7, 0xb4,
-	0x93, 0x71, 0xa1, 0x2a, 0xc1, 0xcb, 0xbf, 0x09, 0x3e, 0x6a, 0x04, 0x1f, 0xd4, 0x82, 0x2d, 0xd1,
-	0xf1, 0xf5, 0x12, 0x36, 0x72, 0xe1, 0xac, 0x10, 0x95, 0xdc, 0xd5, 0x3f, 0xca, 0xb5, 0x44, 0xc7,
-	0xd7, 0x4b, 0x38, 0x02, 0x18, 0x74, 0x96, 0x2b, 0x5b, 0xfb, 0xb9, 0xb2, 0x89, 0xf3, 0x95, 0xd0,
-	0x27, 0x7f, 0x06, 0xf9, 0x8e, 0xab, 0xe9, 0x2b, 0xc8, 0x51, 0x72, 0xf5, 0xdf, 0x99, 0x3e, 0x3e,
-	0xcf, 0xd4, 0x38, 0x89, 0xa7, 0x7b, 0x16, 0x8f, 0x71, 0x3c, 0xb5, 0x7b, 0x76, 0xaa, 0x71, 0xb0,
-	0x6d, 0x5a, 0x54, 0x9f, 0xd4, 0xae, 0x2c, 0xbd, 0x9e, 0x34, 0xcf, 0xc1, 0xe5, 0x72, 0x65, 0x93,
-	0xe1, 0x9b, 0xf5, 0x0f, 0xa6, 0xad, 0x77, 0x8c, 0x6c, 0x76, 0x8c, 0x7c, 0xdf, 0x31, 0xf2, 0x79,
-	0xcf, 0xb4, 0xcd, 0x9e, 0x69, 0xdf, 0xf6, 0x4c, 0x7b, 0xff, 0x22, 0xe1, 0x6a, 0x3a, 0x0b, 0xdd,
-	0x08, 0x33, 0xaf, 0xa9, 0x23, 0xc6, 0x31, 0x8f, 0x78, 0x90, 0x7a, 0x09, 0x3e, 0x6d, 0x5b, 0xfc,
-	0xe9, 0xd8, 0x63, 0xb5, 0xc8, 0x41, 0x86, 0xf7, 0xab, 0xca, 0x3d, 0xff, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x1d, 0xf5, 0x21, 0x4f, 0xe8, 0x02, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xbd, 0x6d, 0xbe, 0xba, 0x39, 0x80, 0xac, 0x08, 0x9c, 0x22, 0x9c, 0xc8, 0x12, 0x52,
+	0x39, 0x60, 0xab, 0x45, 0xe2, 0x90, 0x03, 0x87, 0x80, 0x72, 0x43, 0x8a, 0xcc, 0x97, 0xc4, 0x05,
+	0xd9, 0xc9, 0xc4, 0x5d, 0x11, 0x7b, 0x2c, 0xef, 0xa6, 0x22, 0x6f, 0xd1, 0x63, 0x8e, 0x7d, 0x05,
+	0x8e, 0xbc, 0x00, 0x8a, 0x38, 0xf5, 0xc8, 0xa9, 0x82, 0xe4, 0xc2, 0x99, 0x27, 0x40, 0xde, 0xdd,
+	0xa4, 0x89, 0x48, 0x41, 0x25, 0xbd, 0xcd, 0x7a, 0x66, 0xfe, 0xfb, 0x9f, 0x9f, 0xd6, 0x43, 0x9d,
+	0x90, 0x09, 0x8e, 0x49, 0xe4, 0x0d, 0x82, 0x44, 0xe0, 0x07, 0x48, 0xbc, 0x93, 0xc3, 0x10, 0x44,
+	0x70, 0xe8, 0x45, 0x78, 0xe2, 0xa6, 0x19, 0x0a, 0x34, 0x2d, 0x5d, 0xe3, 0x2e, 0x6a, 0x5c, 0x5d,
+	0xb3, 0x6f, 0xf7, 0x90, 0xc7, 0xc8, 0xbd, 0x30, 0xe0, 0xb0, 0x6c, 0xec, 0x21, 0x4b, 0x54, 0xe7,
+	0x7e, 0x2d, 0xc2, 0x08, 0x65, 0xe8, 0xe5, 0x91, 0xfe, 0xfa, 0xe0, 0xca, 0x3b, 0xd3, 0x20, 0x0b,
+	0x62, 0xae, 0xca, 0x9c, 0x2f, 0x3b, 0xd4, 0x7c, 0x9d, 0xf6, 0x03, 0x01, 0x1d, 0x00, 0xde, 0xcd,
+	0x30, 0x45, 0x1e, 0x0c, 0xcd, 0x1a, 0x2d, 0x0a, 0x26, 0x86, 0x60, 0x91, 0x26, 0x39, 0xd8, 0xf3,
+	0xd5, 0xc1, 0x6c, 0xd2, 0x6a, 0x1f, 0x78, 0x2f, 0x63, 0xa9, 0x60, 0x98, 0x58, 0x3b, 0x32, 0xb7,
+	0xfa, 0xc9, 0xec, 0xd2, 0x3d, 0xc6, 0xf9, 0x08, 0xde, 0x0f, 0x00, 0xac, 0xdd, 0xe6, 0xee, 0x41,
+	0xf5, 0xa8, 0xee, 0x2a, 0xff, 0x6e, 0xee, 0x7f, 0x31, 0x94, 0xfb, 0x0c, 0x59, 0xd2, 0xb6, 0xa6,
+	0x17, 0x0d, 0xe3, 0xd7, 0x45, 0xe3, 0xf6, 0x38, 0x88, 0x87, 0x2d, 0x67, 0xd9, 0xe9, 0xf8, 0x15,
+	0x19, 0x77, 0x00, 0xcc, 0x17, 0xb4, 0x12, 0xb3, 0x44, 0x48, 0xc1, 0xc2, 0xbf, 0x04, 0xef, 0x6a,
+	0xc1, 0x5b, 0x4a, 0x70, 0xd1, 0xe8, 0xf8, 0xe5, 0x3c, 0xd4, 0x72, 0xe1, 0x28, 0x4b, 0xa4, 0x5c,
+	0xf1, 0x9a, 0x72, 0x8b, 0x46, 0xc7, 0x2f, 0xe7, 0x61, 0x07, 0xa0, 0x55, 0x99, 0x9c, 0x35, 0x8c,
+	0x9f, 0x67, 0x0d, 0xe2, 0x7c, 0x25, 0xf4, 0xfe, 0x9f, 0x20, 0xdf, 0x32, 0x71, 0xfc, 0x1c, 0x52,
+	0xe4, 0x4c, 0xfc, 0x37, 0xd3, 0x7b, 0xeb, 0x4c, 0xf3, 0xfc, 0x25, 0x9e, 0xfa, 0x1a, 0x9e, 0x3c,
+	0xb7, 0x1c, 0xb5, 0xbe, 0x36, 0xaa, 0x4c, 0x69, 0xdb, 0xa6, 0x45, 0xcb, 0x7d, 0xe5, 0xca, 0x2a,
+	0xab, 0x8c, 0x3e, 0xb6, 0x0a, 0x93, 0x7c, 0x98, 0x53, 0x42, 0x6d, 0x1f, 0x22, 0xc6, 0x05, 0x64,
+	0x6f, 0x20, 0x63, 0x03, 0x06, 0xfd, 0x97, 0xe3, 0x38, 0xc4, 0xe1, 0xd6, 0x2f, 0xe4, 0x0e, 0x2d,
+	0x71, 0xa9, 0xa4, 0x47, 0xd1, 0xa7, 0x5c, 0xaf, 0x0f, 0x09, 0xc6, 0x7a, 0x0a, 0x75, 0x58, 0xe1,
+	0xfb, 0x89, 0xd0, 0x87, 0x7f, 0xb7, 0x74, 0x13, 0xac, 0xaf, 0xe5, 0x6e, 0x15, 0x63, 0x71, 0x13,
+	0xc6, 0x09, 0xa1, 0x35, 0xf5, 0x26, 0xba, 0xf2, 0x9f, 0xdb, 0x1a, 0xde, 0x53, 0x5a, 0x52, 0x7f,
+	0xaf, 0xb4, 0x57, 0x3d, 0x6a, 0xba, 0x57, 0x6d, 0x0d, 0x57, 0xdd, 0xd8, 0x2e, 0xe4, 0x4f, 0xd8,
+	0xd7, 0x5d, 0x2b, 0x38, 0x3f, 0x13, 0xda, 0xd8, 0x64, 0xed, 0x26, 0x20, 0x6e, 0xe9, 0x72, 0x15,
+	0x6b, 0x61, 0x03, 0xd6, 0xf6, 0xab, 0xe9, 0x0f, 0xdb, 0x98, 0xce, 0x6c, 0x72, 0x3e, 0xb3, 0xc9,
+	0xf7, 0x99, 0x4d, 0x4e, 0xe7, 0xb6, 0x71, 0x3e, 0xb7, 0x8d, 0x6f, 0x73, 0xdb, 0x78, 0xf7, 0x24,
+	0x62, 0xe2, 0x78, 0x14, 0xba, 0x3d, 0x8c, 0x3d, 0x7d, 0x2f, 0x0e, 0x06, 0xac, 0xc7, 0x82, 0xa1,
+	0x17, 0xe1, 0xa3, 0xc5, 0x5a, 0xfc, 0x78, 0xb9, 0x18, 0xc5, 0x38, 0x05, 0x1e, 0x96, 0xe4, 0x42,
+	0x7c, 0xfc, 0x7b, 0x00, 0x43, 0xe1, 0xe1, 0xda, 0xad, 0x05, 0x00, 0x00,
 }
 
 func (this *UpdateFeesProposal) Equal(that interface{}) bool {
@@ -170,13 +349,91 @@ func (this *UpdateFeesProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if !this.IssueFee.Equal(&that1.IssueFee) {
+	if len(this.IssueFee) != len(that1.IssueFee) {
+		return false
+	}
+	for i := range this.IssueFee {
+		if !this.IssueFee[i].Equal(&that1.IssueFee[i]) {
+			return false
+		}
+	}
+	if len(this.MintFee) != len(that1.MintFee) {
+		return false
+	}
+	for i := range this.MintFee {
+		if !this.MintFee[i].Equal(&that1.MintFee[i]) {
+			return false
+		}
+	}
+	if len(this.BurnFee) != len(that1.BurnFee) {
+		return false
+	}
+	for i := range this.BurnFee {
+		if !this.BurnFee[i].Equal(&that1.BurnFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegisterVerifiedSymbolProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterVerifiedSymbolProposal)
+	if !ok {
+		that2, ok := that.(RegisterVerifiedSymbolProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Symbol != that1.Symbol {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
+}
+func (this *UpdateParamsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateParamsProposal)
+	if !ok {
+		that2, ok := that.(UpdateParamsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
 		return false
 	}
-	if !this.MintFee.Equal(&that1.MintFee) {
+	if this.Description != that1.Description {
 		return false
 	}
-	if !this.BurnFee.Equal(&that1.BurnFee) {
+	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
 	return true
@@ -201,36 +458,48 @@ func (m *UpdateFeesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.BurnFee) > 0 {
+		for iNdEx := len(m.BurnFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.MintFee) > 0 {
+		for iNdEx := len(m.MintFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.IssueFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.IssueFee) > 0 {
+		for iNdEx := len(m.IssueFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssueFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -313,80 +582,1034 @@ func (m *UpdateFeesProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RegisterVerifiedSymbolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UpdateFeesProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RegisterVerifiedSymbolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterVerifiedSymbolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterVerifiedSymbolProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterVerifiedSymbolProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterVerifiedSymbolProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateFeesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.IssueFee) > 0 {
+		for _, e := range m.IssueFee {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MintFee) > 0 {
+		for _, e := range m.MintFee {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.BurnFee) > 0 {
+		for _, e := range m.BurnFee {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateFeesProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.IssueFee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MintFee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.BurnFee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterVerifiedSymbolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterVerifiedSymbolProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdateParamsProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateFeesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateFeesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueFee = append(m.IssueFee, types.Coin{})
+			if err := m.IssueFee[len(m.IssueFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintFee = append(m.MintFee, types.Coin{})
+			if err := m.MintFee[len(m.MintFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFee = append(m.BurnFee, types.Coin{})
+			if err := m.BurnFee[len(m.BurnFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateFeesProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateFeesProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterVerifiedSymbolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterVerifiedSymbolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterVerifiedSymbolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.IssueFee.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MintFee.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.BurnFee.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
 
-func (m *UpdateFeesProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.IssueFee)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.MintFee)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.BurnFee)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
+func (m *RegisterVerifiedSymbolProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -409,10 +1632,10 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateFeesProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterVerifiedSymbolProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateFeesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterVerifiedSymbolProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -481,9 +1704,9 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -493,30 +1716,29 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -526,30 +1748,29 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -559,24 +1780,23 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -599,7 +1819,7 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -622,10 +1842,10 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateFeesProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateFeesProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -694,9 +1914,9 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssueFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -706,27 +1926,78 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssueFee = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -754,11 +2025,11 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintFee = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -786,9 +2057,42 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnFee = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
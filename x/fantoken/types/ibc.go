@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PacketMemo is the content of the ICS-20 packet memo carrying the metadata of
+// the fantokens transferred over IBC
+type PacketMemo struct {
+	FanToken *Metadata `json:"fantoken,omitempty"`
+}
+
+// FungibleTokenPacketData is the ICS-20 packet data with the memo field added
+// by the newer ibc-go releases, which the transfer module of the chain does
+// not support yet
+type FungibleTokenPacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo,omitempty"`
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data
+func (data FungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetMemo returns the fantoken metadata of the memo, if any
+func (data FungibleTokenPacketData) GetMemo() (memo PacketMemo, ok bool) {
+	if len(data.Memo) == 0 {
+		return memo, false
+	}
+
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil {
+		return memo, false
+	}
+
+	return memo, memo.FanToken != nil
+}
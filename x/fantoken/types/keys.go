@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -21,6 +24,30 @@ var (
 
 	// PrefixFanTokens defines a prefix for the fan tokens
 	PrefixFanTokens = []byte{0x02}
+
+	// PrefixPausedFanToken defines a denom prefix for the paused fan tokens
+	PrefixPausedFanToken = []byte{0x03}
+
+	// PrefixMintSchedule defines a prefix for the mint schedules
+	PrefixMintSchedule = []byte{0x04}
+
+	// PrefixMintScheduleByDenom defines a prefix for the mint schedules of a fan token
+	PrefixMintScheduleByDenom = []byte{0x05}
+
+	// KeyLastMintScheduleId defines the key of the last mint schedule id
+	KeyLastMintScheduleId = []byte{0x06}
+
+	// PrefixFanTokenBySymbol defines a prefix for the fan tokens indexed by symbol
+	PrefixFanTokenBySymbol = []byte{0x07}
+
+	// PrefixFanTokenByMinter defines a prefix for the fan tokens indexed by minter
+	PrefixFanTokenByMinter = []byte{0x08}
+
+	// PrefixVerifiedSymbol defines a prefix for the symbols reserved to a verified fan token
+	PrefixVerifiedSymbol = []byte{0x09}
+
+	// PrefixMintScheduleQueue defines a prefix for the mint schedules queued by release time
+	PrefixMintScheduleQueue = []byte{0x0A}
 )
 
 // KeyDenom returns the key of the token with the specified denom
@@ -28,7 +55,62 @@ func KeyDenom(denom string) []byte {
 	return append(PrefixFanTokenForDenom, []byte(denom)...)
 }
 
+// KeyPaused returns the pause key of the token with the specified denom
+func KeyPaused(denom string) []byte {
+	return append(PrefixPausedFanToken, []byte(denom)...)
+}
+
+// KeyMintSchedule returns the key of the mint schedule with the specified id
+func KeyMintSchedule(id uint64) []byte {
+	return append(PrefixMintSchedule, sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyMintSchedulesByDenom returns the prefix of the mint schedules of the specified denom
+func KeyMintSchedulesByDenom(denom string) []byte {
+	return append(PrefixMintScheduleByDenom, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// KeyMintScheduleByDenom returns the key of the mint schedule with the specified denom and id
+func KeyMintScheduleByDenom(denom string, id uint64) []byte {
+	return append(KeyMintSchedulesByDenom(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
 // KeyFanTokens returns the key of the specified owner and denom. Intended for querying all fan tokens of an owner
 func KeyFanTokens(owner sdk.AccAddress, denom string) []byte {
 	return append(append(PrefixFanTokens, owner.Bytes()...), []byte(denom)...)
 }
+
+// KeyFanTokensBySymbol returns the prefix of the fan tokens with the specified symbol
+func KeyFanTokensBySymbol(symbol string) []byte {
+	return append(PrefixFanTokenBySymbol, address.MustLengthPrefix([]byte(symbol))...)
+}
+
+// KeyFanTokenBySymbol returns the key of the fan token with the specified symbol and denom
+func KeyFanTokenBySymbol(symbol, denom string) []byte {
+	return append(KeyFanTokensBySymbol(symbol), []byte(denom)...)
+}
+
+// KeyFanTokensByMinter returns the prefix of the fan tokens of the specified minter
+func KeyFanTokensByMinter(minter sdk.AccAddress) []byte {
+	return append(PrefixFanTokenByMinter, address.MustLengthPrefix(minter.Bytes())...)
+}
+
+// KeyFanTokenByMinter returns the key of the fan token with the specified minter and denom
+func KeyFanTokenByMinter(minter sdk.AccAddress, denom string) []byte {
+	return append(KeyFanTokensByMinter(minter), []byte(denom)...)
+}
+
+// KeyVerifiedSymbol returns the key of the verified fan token of the specified symbol
+func KeyVerifiedSymbol(symbol string) []byte {
+	return append(PrefixVerifiedSymbol, []byte(symbol)...)
+}
+
+// KeyMintSchedulesQueueByTime returns the prefix of the mint schedules queued at the specified release time
+func KeyMintSchedulesQueueByTime(releaseTime time.Time) []byte {
+	return append(PrefixMintScheduleQueue, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// KeyMintScheduleQueue returns the key of the mint schedule with the specified release time and id
+func KeyMintScheduleQueue(releaseTime time.Time, id uint64) []byte {
+	return append(KeyMintSchedulesQueueByTime(releaseTime), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMintScheduleReleases is the maximum number of due mint schedules processed in a
+// block, the remaining ones are processed in the next blocks
+const MaxMintScheduleReleases = 100
+
+// NewMintSchedule - construct a new mint schedule
+func NewMintSchedule(id uint64, recipient sdk.AccAddress, amount sdk.Coin, scheduleType ScheduleType, startTime, endTime time.Time) MintSchedule {
+	return MintSchedule{
+		Id:             id,
+		Denom:          amount.Denom,
+		Recipient:      recipient.String(),
+		TotalAmount:    amount.Amount,
+		ReleasedAmount: sdk.ZeroInt(),
+		ScheduleType:   scheduleType,
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+}
+
+// ScheduleTypeFromString parses a schedule type from its short name (linear|cliff)
+func ScheduleTypeFromString(str string) (ScheduleType, error) {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "linear":
+		return ScheduleTypeLinear, nil
+	case "cliff":
+		return ScheduleTypeCliff, nil
+	default:
+		return ScheduleTypeUnspecified, fmt.Errorf("'%s' is not a valid schedule type, expected linear or cliff", str)
+	}
+}
+
+// Validate checks the mint schedule parameters
+func (s MintSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRecipient, "invalid recipient address (%s)", err)
+	}
+
+	if err := ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if s.TotalAmount.IsNil() || !s.TotalAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidMintSchedule, "the total amount must be positive")
+	}
+
+	if s.ReleasedAmount.IsNil() || s.ReleasedAmount.IsNegative() || s.ReleasedAmount.GT(s.TotalAmount) {
+		return sdkerrors.Wrapf(ErrInvalidMintSchedule, "the released amount must be between 0 and %s", s.TotalAmount)
+	}
+
+	return ValidateScheduleTimes(s.ScheduleType, s.StartTime, s.EndTime)
+}
+
+// ValidateScheduleTimes checks the schedule type and its time window
+func ValidateScheduleTimes(scheduleType ScheduleType, startTime, endTime time.Time) error {
+	switch scheduleType {
+	case ScheduleTypeLinear:
+		if !endTime.After(startTime) {
+			return sdkerrors.Wrapf(ErrInvalidMintSchedule, "the end time must be after the start time")
+		}
+	case ScheduleTypeCliff:
+		if endTime.Before(startTime) {
+			return sdkerrors.Wrapf(ErrInvalidMintSchedule, "the end time cannot be before the start time")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidMintSchedule, "invalid schedule type %s", scheduleType)
+	}
+
+	return nil
+}
+
+// VestedAmount returns the amount unlocked by the schedule at the specified time,
+// including the amount already released
+func (s MintSchedule) VestedAmount(blockTime time.Time) sdk.Int {
+	if !blockTime.Before(s.EndTime) {
+		return s.TotalAmount
+	}
+
+	if s.ScheduleType == ScheduleTypeCliff || !blockTime.After(s.StartTime) {
+		return sdk.ZeroInt()
+	}
+
+	elapsed := sdk.NewInt(blockTime.Sub(s.StartTime).Nanoseconds())
+	duration := sdk.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+
+	return s.TotalAmount.Mul(elapsed).Quo(duration)
+}
+
+// ReleasableAmount returns the amount which can be minted to the recipient at the specified time
+func (s MintSchedule) ReleasableAmount(blockTime time.Time) sdk.Int {
+	return s.VestedAmount(blockTime).Sub(s.ReleasedAmount)
+}
+
+// NextReleaseTime returns the time from which the schedule can release an amount
+// again, after the specified time: the end time for the cliff schedules, and the
+// next block after the start time for the linear ones
+func (s MintSchedule) NextReleaseTime(blockTime time.Time) time.Time {
+	if s.ScheduleType == ScheduleTypeCliff {
+		return s.EndTime
+	}
+
+	if blockTime.Before(s.StartTime) {
+		return s.StartTime
+	}
+	return blockTime
+}
+
+// PendingAmount returns the amount still locked into the schedule
+func (s MintSchedule) PendingAmount() sdk.Int {
+	return s.TotalAmount.Sub(s.ReleasedAmount)
+}
+
+// IsCompleted returns true if the whole amount of the schedule has been released
+func (s MintSchedule) IsCompleted() bool {
+	return s.ReleasedAmount.GTE(s.TotalAmount)
+}
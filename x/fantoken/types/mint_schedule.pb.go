@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bitsong/fantoken/v1beta1/mint_schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleType defines how the amount locked into a mint schedule is released
+type ScheduleType int32
+
+const (
+	// SCHEDULE_TYPE_UNSPECIFIED defines an invalid schedule type
+	ScheduleTypeUnspecified ScheduleType = 0
+	// SCHEDULE_TYPE_LINEAR releases the locked amount linearly between the start
+	// and the end time
+	ScheduleTypeLinear ScheduleType = 1
+	// SCHEDULE_TYPE_CLIFF releases the whole locked amount at the end time
+	ScheduleTypeCliff ScheduleType = 2
+)
+
+var ScheduleType_name = map[int32]string{
+	0: "SCHEDULE_TYPE_UNSPECIFIED",
+	1: "SCHEDULE_TYPE_LINEAR",
+	2: "SCHEDULE_TYPE_CLIFF",
+}
+
+var ScheduleType_value = map[string]int32{
+	"SCHEDULE_TYPE_UNSPECIFIED": 0,
+	"SCHEDULE_TYPE_LINEAR":      1,
+	"SCHEDULE_TYPE_CLIFF":       2,
+}
+
+func (x ScheduleType) String() string {
+	return proto.EnumName(ScheduleType_name, int32(x))
+}
+
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0312c4710a88c1da, []int{0}
+}
+
+// MintSchedule defines a portion of the fantoken max supply locked in favour of
+// a recipient and minted according to a release schedule
+type MintSchedule struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// denom of the fantoken to be minted
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// recipient of the minted fantokens
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// total_amount locked into the schedule
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount" yaml:"total_amount"`
+	// released_amount already minted to the recipient
+	ReleasedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=released_amount,json=releasedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"released_amount" yaml:"released_amount"`
+	ScheduleType   ScheduleType                           `protobuf:"varint,6,opt,name=schedule_type,json=scheduleType,proto3,enum=bitsong.fantoken.v1beta1.ScheduleType" json:"schedule_type,omitempty" yaml:"schedule_type"`
+	StartTime      time.Time                              `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime        time.Time                              `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0312c4710a88c1da, []int{0}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("bitsong.fantoken.v1beta1.ScheduleType", ScheduleType_name, ScheduleType_value)
+	proto.RegisterType((*MintSchedule)(nil), "bitsong.fantoken.v1beta1.MintSchedule")
+}
+
+func init() {
+	proto.RegisterFile("bitsong/fantoken/v1beta1/mint_schedule.proto", fileDescriptor_0312c4710a88c1da)
+}
+
+var fileDescriptor_0312c4710a88c1da = []byte{
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc5, 0xed, 0x90, 0x7e, 0x4d, 0x43, 0x3f, 0xa6, 0xa1, 0x18, 0x17, 0x6c, 0xcb, 0x8b, 0x2a,
+	0x42, 0xd4, 0xa6, 0x45, 0x62, 0xd1, 0x5d, 0x93, 0xba, 0x6a, 0xa4, 0x50, 0x55, 0x6e, 0x2a, 0x01,
+	0x1b, 0xcb, 0xb1, 0xc7, 0xee, 0xa8, 0xf6, 0x8c, 0x89, 0x27, 0x88, 0xde, 0x00, 0x75, 0xd5, 0x0b,
+	0x74, 0xc5, 0x0d, 0x38, 0x03, 0x8b, 0x2e, 0xbb, 0x44, 0x2c, 0x02, 0x24, 0x37, 0xc8, 0x09, 0x90,
+	0xbf, 0xa8, 0x83, 0x84, 0x10, 0xab, 0xe4, 0x3f, 0xfe, 0xbd, 0xf7, 0x34, 0xf3, 0x66, 0xc0, 0xb3,
+	0x1e, 0x66, 0x31, 0x25, 0xbe, 0xee, 0xd9, 0x84, 0xd1, 0x73, 0x44, 0xf4, 0xf7, 0xdb, 0x3d, 0xc4,
+	0xec, 0x6d, 0x3d, 0xc4, 0x84, 0x59, 0xb1, 0x73, 0x86, 0xdc, 0x41, 0x80, 0xb4, 0xa8, 0x4f, 0x19,
+	0x85, 0x42, 0x4e, 0x6b, 0x05, 0xad, 0xe5, 0xb4, 0x58, 0xf7, 0xa9, 0x4f, 0x53, 0x48, 0x4f, 0xfe,
+	0x65, 0xbc, 0x28, 0xfb, 0x94, 0xfa, 0x01, 0xd2, 0xd3, 0xa9, 0x37, 0xf0, 0x74, 0x86, 0x43, 0x14,
+	0x33, 0x3b, 0x8c, 0x32, 0x40, 0xfd, 0x52, 0x05, 0xb5, 0x57, 0x98, 0xb0, 0x93, 0x3c, 0x07, 0x2e,
+	0x81, 0x0a, 0x76, 0x05, 0x5e, 0xe1, 0x1b, 0x55, 0xb3, 0x82, 0x5d, 0x58, 0x07, 0x33, 0x2e, 0x22,
+	0x34, 0x14, 0x2a, 0x0a, 0xdf, 0x58, 0x30, 0xb3, 0x01, 0x3e, 0x06, 0x0b, 0x7d, 0xe4, 0xe0, 0x08,
+	0x23, 0xc2, 0x84, 0x7b, 0xe9, 0x97, 0xbb, 0x05, 0x78, 0x06, 0x6a, 0x8c, 0x32, 0x3b, 0xb0, 0xec,
+	0x90, 0x0e, 0x08, 0x13, 0xaa, 0x09, 0xd0, 0x34, 0x6e, 0x86, 0x32, 0xf7, 0x6d, 0x28, 0x6f, 0xfa,
+	0x98, 0x9d, 0x0d, 0x7a, 0x9a, 0x43, 0x43, 0xdd, 0xa1, 0x71, 0x48, 0xe3, 0xfc, 0x67, 0x2b, 0x76,
+	0xcf, 0x75, 0x76, 0x11, 0xa1, 0x58, 0x6b, 0x13, 0x36, 0x19, 0xca, 0x6b, 0x17, 0x76, 0x18, 0xec,
+	0xaa, 0x65, 0x2f, 0xd5, 0x5c, 0x4c, 0xc7, 0xbd, 0x74, 0x82, 0xef, 0xc0, 0x72, 0x1f, 0x05, 0xc8,
+	0x8e, 0x91, 0x5b, 0x84, 0xcd, 0xa4, 0x61, 0x87, 0xff, 0x1d, 0xb6, 0x9e, 0x85, 0xfd, 0x61, 0xa7,
+	0x9a, 0x4b, 0xc5, 0x4a, 0x1e, 0x89, 0xc0, 0xfd, 0xa2, 0x14, 0x2b, 0xd1, 0x0b, 0xb3, 0x0a, 0xdf,
+	0x58, 0xda, 0xd9, 0xd4, 0xfe, 0x56, 0x8d, 0x56, 0x9c, 0x6d, 0xf7, 0x22, 0x42, 0x4d, 0x61, 0x32,
+	0x94, 0xeb, 0x59, 0xd4, 0x94, 0x8d, 0x6a, 0xd6, 0xe2, 0x12, 0x07, 0x5f, 0x03, 0x10, 0x33, 0xbb,
+	0xcf, 0xac, 0xa4, 0x31, 0x61, 0x4e, 0xe1, 0x1b, 0x8b, 0x3b, 0xa2, 0x96, 0xd5, 0xa9, 0x15, 0x75,
+	0x6a, 0xdd, 0xa2, 0xce, 0xe6, 0x93, 0x64, 0xc3, 0x93, 0xa1, 0xbc, 0x9a, 0x7b, 0xff, 0xd6, 0xaa,
+	0x57, 0xdf, 0x65, 0xde, 0x5c, 0x48, 0x17, 0x12, 0x1c, 0x9a, 0x60, 0x1e, 0x11, 0x37, 0xf3, 0x9d,
+	0xff, 0xa7, 0xef, 0x46, 0xee, 0xbb, 0x9c, 0xf9, 0x16, 0xca, 0xcc, 0x75, 0x0e, 0x11, 0x37, 0x41,
+	0x9f, 0x7e, 0xe6, 0x41, 0xad, 0xbc, 0x4d, 0xb8, 0x0b, 0x1e, 0x9d, 0xb4, 0x0e, 0x8d, 0xfd, 0xd3,
+	0x8e, 0x61, 0x75, 0xdf, 0x1c, 0x1b, 0xd6, 0xe9, 0xd1, 0xc9, 0xb1, 0xd1, 0x6a, 0x1f, 0xb4, 0x8d,
+	0xfd, 0x15, 0x4e, 0xdc, 0xb8, 0xbc, 0x56, 0x1e, 0x96, 0x05, 0xa7, 0x24, 0x8e, 0x90, 0x83, 0x3d,
+	0x8c, 0x5c, 0xf8, 0x1c, 0xd4, 0xa7, 0xb5, 0x9d, 0xf6, 0x91, 0xb1, 0x67, 0xae, 0xf0, 0xe2, 0xfa,
+	0xe5, 0xb5, 0x02, 0xcb, 0xb2, 0x0e, 0x26, 0xc8, 0xee, 0x43, 0x0d, 0xac, 0x4d, 0x2b, 0x5a, 0x9d,
+	0xf6, 0xc1, 0xc1, 0x4a, 0x45, 0x7c, 0x70, 0x79, 0xad, 0xac, 0x96, 0x05, 0xad, 0x00, 0x7b, 0x9e,
+	0x58, 0xfd, 0xf8, 0x49, 0xe2, 0x9a, 0xdd, 0x9b, 0x9f, 0x12, 0x77, 0x33, 0x92, 0xf8, 0xdb, 0x91,
+	0xc4, 0xff, 0x18, 0x49, 0xfc, 0xd5, 0x58, 0xe2, 0x6e, 0xc7, 0x12, 0xf7, 0x75, 0x2c, 0x71, 0x6f,
+	0x5f, 0x96, 0x6e, 0x4e, 0x5e, 0x2d, 0xf5, 0x3c, 0xec, 0x60, 0x3b, 0xd0, 0x7d, 0xba, 0x55, 0x3c,
+	0xdb, 0x0f, 0x77, 0x0f, 0x37, 0xbd, 0x4d, 0xbd, 0xd9, 0xf4, 0x10, 0x5f, 0xfc, 0x1a, 0x00, 0x42,
+	0x1a, 0xf9, 0x9f, 0xd9, 0x03, 0x00, 0x00,
+}
+
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintSchedule(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintSchedule(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.ScheduleType != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ReleasedAmount.Size()
+		i -= size
+		if _, err := m.ReleasedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintSchedule(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMintSchedule(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMintSchedule(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovMintSchedule(uint64(l))
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovMintSchedule(uint64(l))
+	if m.ScheduleType != 0 {
+		n += 1 + sovMintSchedule(uint64(m.ScheduleType))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMintSchedule(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMintSchedule(uint64(l))
+	return n
+}
+
+func sovMintSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintSchedule(x uint64) (n int) {
+	return sovMintSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= ScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strings"
+	"time"
 )
 
 const (
@@ -17,6 +18,11 @@ const (
 	TypeMsgSetAuthority = "set_authority"
 	TypeMsgSetMinter    = "set_minter"
 	TypeMsgSetUri       = "set_uri"
+	TypeMsgPause        = "pause"
+	TypeMsgUnpause      = "unpause"
+
+	TypeMsgCreateMintSchedule = "create_mint_schedule"
+	TypeMsgUpdateParams       = "update_params"
 )
 
 var (
@@ -27,6 +33,10 @@ var (
 	_ sdk.Msg = &MsgSetAuthority{}
 	_ sdk.Msg = &MsgSetMinter{}
 	_ sdk.Msg = &MsgSetUri{}
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgUnpause{}
+	_ sdk.Msg = &MsgCreateMintSchedule{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // NewMsgIssue - construct token issue msg.
@@ -67,6 +77,12 @@ func (msg MsgIssue) ValidateBasic() error {
 			URI:       msg.URI,
 			Authority: authority.String(),
 		},
+		RoyaltyBps:     msg.RoyaltyBps,
+		RoyaltyAddress: msg.RoyaltyAddress,
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
 	}
 
 	return fantoken.Validate()
@@ -306,6 +322,10 @@ func (msg MsgMint) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
 	return ValidateDenom(msg.Coin.Denom)
 }
 
@@ -352,6 +372,10 @@ func (msg MsgBurn) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
 	return ValidateDenom(msg.Coin.Denom)
 }
 
@@ -401,3 +425,192 @@ func (msg MsgSetUri) ValidateBasic() error {
 
 	return ValidateDenom(msg.Denom)
 }
+
+// NewMsgPause creates a MsgPause
+func NewMsgPause(denom string, authority string) *MsgPause {
+	return &MsgPause{
+		Denom:     denom,
+		Authority: authority,
+	}
+}
+
+// Route implements Msg
+func (msg MsgPause) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgPause) Type() string { return TypeMsgPause }
+
+// GetSignBytes implements Msg
+func (msg MsgPause) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgPause) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgPause) ValidateBasic() error {
+	// check the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return ValidateDenom(msg.Denom)
+}
+
+// NewMsgUnpause creates a MsgUnpause
+func NewMsgUnpause(denom string, authority string) *MsgUnpause {
+	return &MsgUnpause{
+		Denom:     denom,
+		Authority: authority,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnpause) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnpause) Type() string { return TypeMsgUnpause }
+
+// GetSignBytes implements Msg
+func (msg MsgUnpause) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnpause) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnpause) ValidateBasic() error {
+	// check the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return ValidateDenom(msg.Denom)
+}
+
+// NewMsgCreateMintSchedule creates a MsgCreateMintSchedule
+func NewMsgCreateMintSchedule(recipient string, amount sdk.Coin, scheduleType ScheduleType, startTime, endTime time.Time, minter string) *MsgCreateMintSchedule {
+	return &MsgCreateMintSchedule{
+		Recipient:    recipient,
+		Amount:       amount,
+		ScheduleType: scheduleType,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Minter:       minter,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCreateMintSchedule) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgCreateMintSchedule) Type() string { return TypeMsgCreateMintSchedule }
+
+// GetSignBytes implements Msg
+func (msg MsgCreateMintSchedule) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateMintSchedule) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCreateMintSchedule) ValidateBasic() error {
+	// check the minter
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+
+	// check the recipient
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if err := ValidateAmount(msg.Amount.Amount); err != nil {
+		return err
+	}
+
+	if err := ValidateDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
+	return ValidateScheduleTimes(msg.ScheduleType, msg.StartTime, msg.EndTime)
+}
+
+// NewMsgUpdateParams creates a MsgUpdateParams
+func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateParams) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	// check the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
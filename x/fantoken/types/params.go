@@ -6,6 +6,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -15,22 +18,32 @@ var (
 	KeyIssueFee = []byte("IssueFee")
 	KeyMintFee  = []byte("MintFee")
 	KeyBurnFee  = []byte("BurnFee")
+
+	KeyMaxRoyaltyBps = []byte("MaxRoyaltyBps")
+	KeyMemoChannels  = []byte("MemoChannels")
 )
 
+// MaxBasisPoints is the amount of basis points representing the 100%
+const MaxBasisPoints = 10_000
+
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyIssueFee, &p.IssueFee, validateFee),
 		paramtypes.NewParamSetPair(KeyMintFee, &p.MintFee, validateFee),
 		paramtypes.NewParamSetPair(KeyBurnFee, &p.BurnFee, validateFee),
+		paramtypes.NewParamSetPair(KeyMaxRoyaltyBps, &p.MaxRoyaltyBps, validateMaxRoyaltyBps),
+		paramtypes.NewParamSetPair(KeyMemoChannels, &p.MemoChannels, validateMemoChannels),
 	}
 }
 
 // NewParams constructs a new Params instance
-func NewParams(issueFee, mintFee, burnFee, transferFee sdk.Coin) Params {
+func NewParams(issueFee, mintFee, burnFee []sdk.Coin, maxRoyaltyBps uint32, memoChannels []string) Params {
 	return Params{
-		IssueFee: issueFee,
-		MintFee:  mintFee,
-		BurnFee:  burnFee,
+		IssueFee:      issueFee,
+		MintFee:       mintFee,
+		BurnFee:       burnFee,
+		MaxRoyaltyBps: maxRoyaltyBps,
+		MemoChannels:  memoChannels,
 	}
 }
 
@@ -42,9 +55,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams return the default params
 func DefaultParams() Params {
 	return Params{
-		IssueFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000_000)),
-		MintFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
-		BurnFee:  sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+		IssueFee:      []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000_000))},
+		MintFee:       []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+		BurnFee:       []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+		MaxRoyaltyBps: 1_000,
 	}
 }
 
@@ -68,16 +82,51 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxRoyaltyBps(p.MaxRoyaltyBps); err != nil {
+		return err
+	}
+
+	if err := validateMemoChannels(p.MemoChannels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func validateFee(i interface{}) error {
-	v, ok := i.(sdk.Coin)
+	v, ok := i.([]sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return feestypes.ValidateAcceptedFees(v)
+}
+
+func validateMaxRoyaltyBps(i interface{}) error {
+	v, ok := i.(uint32)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if v.IsNegative() {
-		return fmt.Errorf("fee should not be negative")
+	if v > MaxBasisPoints {
+		return fmt.Errorf("max royalty should not exceed %d basis points", MaxBasisPoints)
+	}
+	return nil
+}
+
+func validateMemoChannels(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, channel := range v {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return err
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate memo channel %s", channel)
+		}
+		seen[channel] = true
 	}
 	return nil
 }
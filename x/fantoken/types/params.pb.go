@@ -26,9 +26,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines fantoken module's parameters
 type Params struct {
-	IssueFee types.Coin `protobuf:"bytes,1,opt,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
-	MintFee  types.Coin `protobuf:"bytes,2,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
-	BurnFee  types.Coin `protobuf:"bytes,3,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee" yaml:"burn_fee"`
+	// issue_fee lists the accepted fees for issuing a fantoken, the first one is
+	// charged when the payer does not choose a fee denom
+	IssueFee []types.Coin `protobuf:"bytes,1,rep,name=issue_fee,json=issueFee,proto3" json:"issue_fee" yaml:"issue_fee"`
+	// mint_fee lists the accepted fees for minting a fantoken
+	MintFee []types.Coin `protobuf:"bytes,2,rep,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+	// burn_fee lists the accepted fees for burning a fantoken
+	BurnFee []types.Coin `protobuf:"bytes,3,rep,name=burn_fee,json=burnFee,proto3" json:"burn_fee" yaml:"burn_fee"`
+	// max_royalty_bps is the maximum royalty, in basis points, that can be set
+	// on a fantoken
+	MaxRoyaltyBps uint32 `protobuf:"varint,4,opt,name=max_royalty_bps,json=maxRoyaltyBps,proto3" json:"max_royalty_bps,omitempty" yaml:"max_royalty_bps"`
+	// memo_channels lists the source channels of the ICS-20 packets carrying
+	// the fantoken metadata in their memo, the counterparty chains of the other
+	// channels receive plain packets
+	MemoChannels []string `protobuf:"bytes,5,rep,name=memo_channels,json=memoChannels,proto3" json:"memo_channels,omitempty" yaml:"memo_channels"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,27 +83,32 @@ func init() {
 }
 
 var fileDescriptor_6f504cadfa8bc50f = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xca, 0x2c, 0x29,
-	0xce, 0xcf, 0x4b, 0xd7, 0x4f, 0x4b, 0xcc, 0x2b, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x92, 0x4b, 0xce, 0x2f,
-	0xce, 0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0x85, 0xeb, 0x4d, 0xce, 0xcf, 0xcc, 0x83, 0xe8,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x13, 0x13,
-	0x17, 0x5b, 0x00, 0xd8, 0x02, 0xa1, 0x00, 0x2e, 0xce, 0xcc, 0xe2, 0xe2, 0xd2, 0xd4, 0xf8, 0xb4,
-	0xd4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0x88, 0xa1, 0x7a, 0x20, 0x43,
-	0x61, 0x36, 0xe9, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0x49, 0x9c, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e,
-	0xbc, 0x40, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x5c, 0xa7, 0x52, 0x10, 0x07, 0x98, 0xed, 0x96,
-	0x9a, 0x2a, 0xe4, 0xcb, 0xc5, 0x91, 0x9b, 0x99, 0x57, 0x02, 0x36, 0x90, 0x89, 0x90, 0x81, 0xe2,
-	0x50, 0x03, 0xf9, 0x21, 0x06, 0xc2, 0x34, 0x2a, 0x05, 0xb1, 0x83, 0x98, 0x50, 0xe3, 0x92, 0x4a,
-	0x8b, 0xf2, 0xc0, 0xc6, 0x31, 0x93, 0x68, 0x1c, 0x4c, 0xa3, 0x52, 0x10, 0x3b, 0x88, 0xe9, 0x96,
-	0x9a, 0x6a, 0xc5, 0x31, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x21, 0x27, 0x1e,
-	0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x34, 0xf4, 0xf3, 0xd3, 0xd2, 0x32, 0x93,
-	0x33, 0x13, 0x73, 0xf4, 0xd3, 0xf3, 0x75, 0x61, 0xf1, 0x56, 0x81, 0x88, 0xb9, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0x70, 0x08, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x21, 0xe4, 0x45,
-	0x94, 0xda, 0x01, 0x00, 0x00,
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6b, 0xdb, 0x40,
+	0x18, 0xc6, 0xa5, 0xaa, 0xf5, 0x1f, 0xb5, 0xc6, 0x45, 0x98, 0x56, 0xf5, 0x70, 0x32, 0x82, 0x82,
+	0x97, 0x4a, 0xb8, 0x85, 0x0e, 0x86, 0x2e, 0x32, 0x74, 0x2b, 0x18, 0xd1, 0xa9, 0x8b, 0x38, 0x89,
+	0x93, 0x7c, 0x54, 0x77, 0x27, 0x74, 0xe7, 0x60, 0x7d, 0x80, 0xec, 0x19, 0x33, 0xfa, 0xe3, 0x78,
+	0xf4, 0x98, 0x49, 0x24, 0xf6, 0x92, 0xd9, 0x9f, 0x20, 0x48, 0x3a, 0x39, 0xc9, 0x14, 0xb2, 0x3d,
+	0xef, 0xab, 0xe7, 0xf9, 0xbd, 0x82, 0x7b, 0xf4, 0xaf, 0x21, 0x16, 0x9c, 0xd1, 0xc4, 0x8d, 0x21,
+	0x15, 0xec, 0x3f, 0xa2, 0xee, 0xc5, 0x2c, 0x44, 0x02, 0xce, 0xdc, 0x0c, 0xe6, 0x90, 0x70, 0x27,
+	0xcb, 0x99, 0x60, 0x86, 0x29, 0x6d, 0x4e, 0x6b, 0x73, 0xa4, 0x6d, 0x0c, 0x22, 0xc6, 0x09, 0xe3,
+	0x6e, 0x08, 0x39, 0x3a, 0x67, 0x23, 0x86, 0x69, 0x93, 0x1c, 0x8f, 0x12, 0x96, 0xb0, 0x5a, 0xba,
+	0x95, 0x6a, 0xb6, 0xf6, 0xa5, 0xa6, 0x77, 0x96, 0xf5, 0x01, 0x63, 0xa9, 0xf7, 0x31, 0xe7, 0x6b,
+	0x14, 0xc4, 0x08, 0x99, 0xea, 0x44, 0x9b, 0xbe, 0xff, 0xfe, 0xc5, 0x69, 0xa0, 0x4e, 0x05, 0x6d,
+	0x2f, 0x39, 0x0b, 0x86, 0xa9, 0x67, 0xee, 0x4a, 0x4b, 0x39, 0x95, 0xd6, 0xc7, 0x02, 0x92, 0x74,
+	0x6e, 0x9f, 0x93, 0xb6, 0xdf, 0xab, 0xf5, 0x6f, 0x84, 0x8c, 0x3f, 0x7a, 0x8f, 0x60, 0x2a, 0x6a,
+	0xe0, 0x9b, 0x97, 0x80, 0x9f, 0x25, 0x70, 0xd8, 0x00, 0xdb, 0xa0, 0xed, 0x77, 0x2b, 0x29, 0x71,
+	0xe1, 0x3a, 0xa7, 0x35, 0x4e, 0x7b, 0x25, 0xae, 0x0d, 0xda, 0x7e, 0xb7, 0x92, 0x15, 0xce, 0xd3,
+	0x87, 0x04, 0x6e, 0x82, 0x9c, 0x15, 0x30, 0x15, 0x45, 0x10, 0x66, 0xdc, 0x7c, 0x3b, 0x51, 0xa7,
+	0x03, 0x6f, 0x7c, 0x2a, 0xad, 0x4f, 0xf2, 0x2f, 0x9e, 0x1b, 0x6c, 0x7f, 0x40, 0xe0, 0xc6, 0x6f,
+	0x16, 0x5e, 0xc6, 0x8d, 0x5f, 0xfa, 0x80, 0x20, 0xc2, 0x82, 0x68, 0x05, 0x29, 0x45, 0x29, 0x37,
+	0xdf, 0x4d, 0xb4, 0x69, 0xdf, 0x33, 0x4f, 0xa5, 0x35, 0x92, 0x84, 0xa7, 0x9f, 0x6d, 0xff, 0x43,
+	0x35, 0x2f, 0xe4, 0x38, 0xef, 0x5d, 0x6f, 0x2d, 0xe5, 0x7e, 0x6b, 0xa9, 0xde, 0xdf, 0xdd, 0x1d,
+	0x50, 0x76, 0x07, 0xa0, 0xee, 0x0f, 0x40, 0xbd, 0x3d, 0x00, 0xf5, 0xea, 0x08, 0x94, 0xfd, 0x11,
+	0x28, 0x37, 0x47, 0xa0, 0xfc, 0xfb, 0x99, 0x60, 0xb1, 0x5a, 0x87, 0x4e, 0xc4, 0x88, 0x2b, 0x0b,
+	0xc0, 0xe2, 0x18, 0x47, 0x18, 0xa6, 0x6e, 0xc2, 0xbe, 0xb5, 0xd5, 0xd9, 0x3c, 0x96, 0x47, 0x14,
+	0x19, 0xe2, 0x61, 0xa7, 0x7e, 0xe4, 0x1f, 0x0f, 0x03, 0x00, 0x5e, 0xf4, 0x75, 0x9f, 0x5d, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -114,15 +130,41 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.IssueFee.Equal(&that1.IssueFee) {
+	if len(this.IssueFee) != len(that1.IssueFee) {
 		return false
 	}
-	if !this.MintFee.Equal(&that1.MintFee) {
+	for i := range this.IssueFee {
+		if !this.IssueFee[i].Equal(&that1.IssueFee[i]) {
+			return false
+		}
+	}
+	if len(this.MintFee) != len(that1.MintFee) {
+		return false
+	}
+	for i := range this.MintFee {
+		if !this.MintFee[i].Equal(&that1.MintFee[i]) {
+			return false
+		}
+	}
+	if len(this.BurnFee) != len(that1.BurnFee) {
+		return false
+	}
+	for i := range this.BurnFee {
+		if !this.BurnFee[i].Equal(&that1.BurnFee[i]) {
+			return false
+		}
+	}
+	if this.MaxRoyaltyBps != that1.MaxRoyaltyBps {
 		return false
 	}
-	if !this.BurnFee.Equal(&that1.BurnFee) {
+	if len(this.MemoChannels) != len(that1.MemoChannels) {
 		return false
 	}
+	for i := range this.MemoChannels {
+		if this.MemoChannels[i] != that1.MemoChannels[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,36 +187,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.MemoChannels) > 0 {
+		for iNdEx := len(m.MemoChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemoChannels[iNdEx])
+			copy(dAtA[i:], m.MemoChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MemoChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.MaxRoyaltyBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRoyaltyBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BurnFee) > 0 {
+		for iNdEx := len(m.BurnFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.IssueFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.MintFee) > 0 {
+		for iNdEx := len(m.MintFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IssueFee) > 0 {
+		for iNdEx := len(m.IssueFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssueFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -195,12 +263,33 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.IssueFee.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MintFee.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.BurnFee.Size()
-	n += 1 + l + sovParams(uint64(l))
+	if len(m.IssueFee) > 0 {
+		for _, e := range m.IssueFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintFee) > 0 {
+		for _, e := range m.MintFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BurnFee) > 0 {
+		for _, e := range m.BurnFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRoyaltyBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxRoyaltyBps))
+	}
+	if len(m.MemoChannels) > 0 {
+		for _, s := range m.MemoChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,7 +357,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IssueFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IssueFee = append(m.IssueFee, types.Coin{})
+			if err := m.IssueFee[len(m.IssueFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -301,7 +391,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MintFee = append(m.MintFee, types.Coin{})
+			if err := m.MintFee[len(m.MintFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -334,10 +425,62 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BurnFee = append(m.BurnFee, types.Coin{})
+			if err := m.BurnFee[len(m.BurnFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoyaltyBps", wireType)
+			}
+			m.MaxRoyaltyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoyaltyBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoChannels = append(m.MemoChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7,6 +7,14 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
+	merkledroptypes "github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 )
 
 const (
@@ -22,9 +30,27 @@ const (
 	MinimumUriLen = 0
 	// MaximumUriLen is the maximum limitation for the length of the fantoken's uri
 	MaximumUriLen = 512
+	// MaxCheckHolders is the maximum number of addresses checked by a single CheckHolders query
+	MaxCheckHolders = 100
 )
 
 var (
+	// royaltyBlockedModules are the module accounts known to the fantoken
+	// module that cannot receive the royalties, since their balance is
+	// accounted by the modules; the keeper rejects all the module accounts of
+	// the app when a fantoken is issued
+	royaltyBlockedModules = []string{
+		authtypes.FeeCollectorName,
+		distrtypes.ModuleName,
+		minttypes.ModuleName,
+		stakingtypes.BondedPoolName,
+		stakingtypes.NotBondedPoolName,
+		govtypes.ModuleName,
+		ModuleName,
+		merkledroptypes.ModuleName,
+		feestypes.ModuleName,
+	}
+
 	regexpSymbolFmt = fmt.Sprintf("^[a-z0-9]{%d,%d}$", MinimumSymbolLen-1, MaximumSymbolLen-1)
 	regexpSymbol    = regexp.MustCompile(regexpSymbolFmt).MatchString
 )
@@ -77,18 +103,67 @@ func ValidateUri(uri string) error {
 	return nil
 }
 
-func ValidateFees(issueFee, mintFee, burnFee sdk.Coin) error {
-	if err := issueFee.Validate(); err != nil {
+// ValidateRoyalty checks if the given royalty basis points and address are valid
+func ValidateRoyalty(royaltyBps uint32, royaltyAddress string) error {
+	if royaltyBps > MaxBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid royalty %d, only accepts basis points [0, %d]", royaltyBps, MaxBasisPoints)
+	}
+
+	if len(royaltyAddress) > 0 {
+		addr, err := sdk.AccAddressFromBech32(royaltyAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty address (%s)", err)
+		}
+
+		for _, name := range royaltyBlockedModules {
+			if addr.Equals(authtypes.NewModuleAddress(name)) {
+				return sdkerrors.Wrapf(ErrInvalidRoyalty, "the royalty address %s is the %s module account", royaltyAddress, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateFees checks the accepted issue, mint and burn fees
+func ValidateFees(issueFee, mintFee, burnFee []sdk.Coin) error {
+	if err := feestypes.ValidateAcceptedFees(issueFee); err != nil {
+		return err
+	}
+
+	if err := feestypes.ValidateAcceptedFees(mintFee); err != nil {
 		return err
 	}
 
-	if err := mintFee.Validate(); err != nil {
+	if err := feestypes.ValidateAcceptedFees(burnFee); err != nil {
 		return err
 	}
 
-	if err := burnFee.Validate(); err != nil {
+	return nil
+}
+
+// ValidateFeeDenom checks the denom chosen to pay a fee, empty for the default one
+func ValidateFeeDenom(denom string) error {
+	if len(denom) == 0 {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee denom (%s)", err)
+	}
+
+	return nil
+}
+
+// ValidateHolderThreshold checks the fantoken and the min amount held
+func ValidateHolderThreshold(denom string, minAmount sdk.Int) error {
+	if err := ValidateDenom(denom); err != nil {
 		return err
 	}
 
+	if minAmount.IsNil() || !minAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "the min amount %s must be positive", minAmount)
+	}
+
 	return nil
 }
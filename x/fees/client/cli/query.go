@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+)
+
+// GetQueryCmd returns the query commands for the fees module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                types.ModuleName,
+		Short:              "Querying commands for the fees module",
+		DisableFlagParsing: true,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams implements the query fees related param command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query values set as fees parameters.",
+		Example: fmt.Sprintf("$ %s query fees params", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
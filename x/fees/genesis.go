@@ -0,0 +1,22 @@
+package fees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fees/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+)
+
+// InitGenesis stores the genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParamSet(ctx, data.Params)
+}
+
+// ExportGenesis outputs the genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParamSet(ctx))
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params return the all the parameter in fees module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+type Keeper struct {
+	paramSpace  types.ParamSubspace
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
+}
+
+func NewKeeper(
+	paramSpace types.ParamSubspace,
+	ak types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+) Keeper {
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the " + types.ModuleName + " module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:  paramSpace,
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("go-bitsong/%s", types.ModuleName))
+}
+
+// DistributeFees distributes the fees paid by the payer for the action of the
+// module according to the fee split param, emitting an event for each movement
+func (k Keeper) DistributeFees(ctx sdk.Context, module, action string, payer sdk.AccAddress, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	split := k.GetParamSet(ctx).FeeSplit
+
+	// the community pool receives the remainder of the truncated shares
+	remainder := fees
+
+	burned := shareOf(fees, split.Burn)
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+		emitFeeEvent(ctx, module, action, payer, types.DestinationBurn, nil, burned)
+		remainder = remainder.Sub(burned)
+	}
+
+	collected := shareOf(fees, split.FeeCollector)
+	if !collected.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, collected); err != nil {
+			return err
+		}
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		emitFeeEvent(ctx, module, action, payer, types.DestinationFeeCollector, feeCollector, collected)
+		remainder = remainder.Sub(collected)
+	}
+
+	received := shareOf(fees, split.RecipientShare)
+	if !received.IsZero() {
+		recipient, err := sdk.AccAddressFromBech32(split.Recipient)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, payer, recipient, received); err != nil {
+			return err
+		}
+		emitFeeEvent(ctx, module, action, payer, types.DestinationRecipient, recipient, received)
+		remainder = remainder.Sub(received)
+	}
+
+	if !remainder.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, remainder, payer); err != nil {
+			return err
+		}
+		distribution := authtypes.NewModuleAddress(distrtypes.ModuleName)
+		emitFeeEvent(ctx, module, action, payer, types.DestinationCommunityPool, distribution, remainder)
+	}
+
+	return nil
+}
+
+// shareOf returns the truncated share of the coins
+func shareOf(coins sdk.Coins, share sdk.Dec) sdk.Coins {
+	if !share.IsPositive() {
+		return sdk.Coins{}
+	}
+	amount, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(share).TruncateDecimal()
+	return amount
+}
+
+func emitFeeEvent(ctx sdk.Context, module, action string, payer sdk.AccAddress, destination string, recipient sdk.AccAddress, amount sdk.Coins) {
+	event := &types.EventFee{
+		Module:      module,
+		Action:      action,
+		Payer:       payer.String(),
+		Destination: destination,
+		Amount:      amount,
+	}
+	if !recipient.Empty() {
+		event.Recipient = recipient.String()
+	}
+	ctx.EventManager().EmitTypedEvent(event)
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/bitsongofficial/go-bitsong/app"
+	"github.com/bitsongofficial/go-bitsong/x/fees/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+var (
+	payer     = sdk.AccAddress(tmhash.SumTruncated([]byte("payer")))
+	recipient = sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	initCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	bk     bankkeeper.Keeper
+	dk     distrkeeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.keeper = app.FeesKeeper
+	suite.bk = app.BankKeeper
+	suite.dk = app.DistrKeeper
+
+	suite.NoError(suite.bk.MintCoins(suite.ctx, minttypes.ModuleName, initCoins))
+	suite.NoError(suite.bk.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, payer, initCoins))
+}
+
+func TestKeeperSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesDefault() {
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	communityPool := suite.dk.GetFeePoolCommunityCoins(suite.ctx)
+
+	suite.NoError(suite.keeper.DistributeFees(suite.ctx, "fantoken", "issue", payer, fees))
+
+	suite.Equal(
+		communityPool.Add(sdk.NewDecCoinsFromCoins(fees...)...),
+		suite.dk.GetFeePoolCommunityCoins(suite.ctx),
+	)
+	suite.Equal(sdk.NewInt(999_000), suite.bk.GetBalance(suite.ctx, payer, sdk.DefaultBondDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesSplit() {
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(types.NewFeeSplit(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1), recipient, sdk.NewDecWithPrec(4, 1),
+	)))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1005)))
+	communityPool := suite.dk.GetFeePoolCommunityCoins(suite.ctx)
+	supply := suite.bk.GetSupply(suite.ctx, sdk.DefaultBondDenom)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	collected := suite.bk.GetBalance(suite.ctx, feeCollector, sdk.DefaultBondDenom)
+
+	suite.NoError(suite.keeper.DistributeFees(suite.ctx, "merkledrop", "create", payer, fees))
+
+	// the shares are truncated: 201 burned, 301 collected, 402 to the recipient
+	// and the remaining 101 to the community pool
+	suite.Equal(supply.Amount.SubRaw(201), suite.bk.GetSupply(suite.ctx, sdk.DefaultBondDenom).Amount)
+	suite.Equal(collected.Amount.AddRaw(301), suite.bk.GetBalance(suite.ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	suite.Equal(sdk.NewInt(402), suite.bk.GetBalance(suite.ctx, recipient, sdk.DefaultBondDenom).Amount)
+	suite.Equal(
+		communityPool.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(101))),
+		suite.dk.GetFeePoolCommunityCoins(suite.ctx),
+	)
+
+	// an event for each fee movement
+	var events int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == "bitsong.fees.v1beta1.EventFee" {
+			events++
+		}
+	}
+	suite.Equal(4, events)
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesInsufficientFunds() {
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2_000_000)))
+	suite.Error(suite.keeper.DistributeFees(suite.ctx, "fantoken", "mint", payer, fees))
+}
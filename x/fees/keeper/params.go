@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParamSet returns fees params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParamSet sets fees params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
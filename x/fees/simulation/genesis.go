@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/fees/types"
+)
+
+// Simulation parameter constants
+const (
+	FeeSplit = "fee_split"
+)
+
+// RandomFeeSplit returns a fee split with random shares, sending a share to a
+// random sim account
+func RandomFeeSplit(r *rand.Rand, accs []simtypes.Account) types.FeeSplit {
+	recipient, _ := simtypes.RandomAcc(r, accs)
+
+	burn := sdk.NewDecWithPrec(int64(r.Intn(26)), 2)
+	feeCollector := sdk.NewDecWithPrec(int64(r.Intn(26)), 2)
+	recipientShare := sdk.NewDecWithPrec(int64(r.Intn(26)), 2)
+	communityPool := sdk.OneDec().Sub(burn).Sub(feeCollector).Sub(recipientShare)
+
+	return types.NewFeeSplit(communityPool, burn, feeCollector, recipient.Address, recipientShare)
+}
+
+// RandomizedGenState generates a random GenesisState for the fees module
+func RandomizedGenState(simState *module.SimulationState) {
+	var feeSplit types.FeeSplit
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeSplit, &feeSplit, simState.Rand,
+		func(r *rand.Rand) { feeSplit = RandomFeeSplit(r, simState.Accounts) },
+	)
+
+	feesGenesis := types.NewGenesisState(types.NewParams(feeSplit))
+
+	bz, err := json.MarshalIndent(&feesGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fees parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feesGenesis)
+}
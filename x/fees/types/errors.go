@@ -0,0 +1,8 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrInvalidFees         = sdkerrors.Register(ModuleName, 1, "invalid fees")
+	ErrFeeDenomNotAccepted = sdkerrors.Register(ModuleName, 2, "fee denom not accepted")
+)
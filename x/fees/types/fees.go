@@ -0,0 +1,76 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateAcceptedFees checks the list of the fees accepted by a module
+// action: every fee must be a valid coin and the denoms must be unique. The
+// list is ordered, since the first fee is the default one
+func ValidateAcceptedFees(fees []sdk.Coin) error {
+	denoms := make(map[string]bool, len(fees))
+	for _, fee := range fees {
+		if err := fee.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidFees, "%s: %s", fee, err)
+		}
+		if denoms[fee.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidFees, "duplicated fee denom %s", fee.Denom)
+		}
+		denoms[fee.Denom] = true
+	}
+
+	return nil
+}
+
+// SelectFee returns the fee to pay among the accepted ones: the fee in the
+// given denom or, when the denom is empty, the first accepted fee. The
+// returned coins are empty when there is nothing to pay
+func SelectFee(fees []sdk.Coin, denom string) (sdk.Coins, error) {
+	if len(fees) == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	if denom == "" {
+		return sdk.NewCoins(fees[0]), nil
+	}
+
+	for _, fee := range fees {
+		if fee.Denom == denom {
+			return sdk.NewCoins(fee), nil
+		}
+	}
+
+	return nil, sdkerrors.Wrapf(ErrFeeDenomNotAccepted, "%s is not accepted, expected one of %s", denom, FeesString(fees))
+}
+
+// ParseAcceptedFees parses a comma separated list of fees, like
+// "1000ubtsg,10ibc/...", preserving their order
+func ParseAcceptedFees(s string) ([]sdk.Coin, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return []sdk.Coin{}, nil
+	}
+
+	var fees []sdk.Coin
+	for _, str := range strings.Split(s, ",") {
+		fee, err := sdk.ParseCoinNormalized(strings.TrimSpace(str))
+		if err != nil {
+			return nil, err
+		}
+		fees = append(fees, fee)
+	}
+
+	return fees, ValidateAcceptedFees(fees)
+}
+
+// FeesString returns the accepted fees as a comma separated list
+func FeesString(fees []sdk.Coin) string {
+	strs := make([]string, len(fees))
+	for i, fee := range fees {
+		strs[i] = fee.String()
+	}
+	return strings.Join(strs, ",")
+}
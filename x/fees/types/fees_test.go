@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectFee(t *testing.T) {
+	fees := []sdk.Coin{sdk.NewInt64Coin("ubtsg", 100), sdk.NewInt64Coin("uusdc", 10), sdk.NewInt64Coin("uatom", 0)}
+
+	fee, err := SelectFee(fees, "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100)), fee)
+
+	fee, err = SelectFee(fees, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)), fee)
+
+	fee, err = SelectFee(fees, "uatom")
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+
+	_, err = SelectFee(fees, "uosmo")
+	require.ErrorIs(t, err, ErrFeeDenomNotAccepted)
+
+	fee, err = SelectFee(nil, "uosmo")
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+}
+
+func TestParseAcceptedFees(t *testing.T) {
+	fees, err := ParseAcceptedFees("100ubtsg, 10uusdc")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin("ubtsg", 100), sdk.NewInt64Coin("uusdc", 10)}, fees)
+
+	fees, err = ParseAcceptedFees("")
+	require.NoError(t, err)
+	require.Empty(t, fees)
+
+	_, err = ParseAcceptedFees("100ubtsg,10ubtsg")
+	require.ErrorIs(t, err, ErrInvalidFees)
+
+	_, err = ParseAcceptedFees("ubtsg")
+	require.Error(t, err)
+}
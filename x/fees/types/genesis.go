@@ -0,0 +1,19 @@
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the fees module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate validates the provided genesis state to ensure the
+// expected invariants holds.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "fees"
+
+	// QuerierRoute is the querier route for the module
+	QuerierRoute string = ModuleName
+
+	// RouterKey is the msg router key for the module
+	RouterKey string = ModuleName
+)
+
+// Destinations of the fees
+const (
+	DestinationCommunityPool = "community_pool"
+	DestinationBurn          = "burn"
+	DestinationFeeCollector  = "fee_collector"
+	DestinationRecipient     = "recipient"
+)
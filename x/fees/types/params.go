@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// parameter keys
+var (
+	KeyFeeSplit = []byte("FeeSplit")
+)
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeSplit, &p.FeeSplit, validateFeeSplit),
+	}
+}
+
+// NewParams constructs a new Params instance
+func NewParams(feeSplit FeeSplit) Params {
+	return Params{
+		FeeSplit: feeSplit,
+	}
+}
+
+// ParamKeyTable returns the TypeTable for the fees module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams return the default params, sending all the fees to the
+// community pool
+func DefaultParams() Params {
+	return Params{
+		FeeSplit: NewFeeSplit(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(), nil, sdk.ZeroDec()),
+	}
+}
+
+// String returns a human readable string representation of the parameters.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate validates the given params
+func (p Params) Validate() error {
+	return validateFeeSplit(p.FeeSplit)
+}
+
+// NewFeeSplit constructs a new FeeSplit instance
+func NewFeeSplit(communityPool, burn, feeCollector sdk.Dec, recipient sdk.AccAddress, recipientShare sdk.Dec) FeeSplit {
+	split := FeeSplit{
+		CommunityPool:  communityPool,
+		Burn:           burn,
+		FeeCollector:   feeCollector,
+		RecipientShare: recipientShare,
+	}
+	if !recipient.Empty() {
+		split.Recipient = recipient.String()
+	}
+	return split
+}
+
+// Validate checks that the shares are in [0, 1] and add up to one, and that
+// the recipient is set when it receives a share
+func (s FeeSplit) Validate() error {
+	destinations := []string{DestinationCommunityPool, DestinationBurn, DestinationFeeCollector, DestinationRecipient}
+	shares := []sdk.Dec{s.CommunityPool, s.Burn, s.FeeCollector, s.RecipientShare}
+
+	total := sdk.ZeroDec()
+	for i, share := range shares {
+		if share.IsNil() || share.IsNegative() || share.GT(sdk.OneDec()) {
+			return fmt.Errorf("%s share should be in [0, 1]: %s", destinations[i], share)
+		}
+		total = total.Add(share)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("the shares should add up to 1: %s", total)
+	}
+
+	if len(s.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient address: %w", err)
+		}
+	} else if s.RecipientShare.IsPositive() {
+		return fmt.Errorf("the recipient share needs a recipient address")
+	}
+
+	return nil
+}
+
+func validateFeeSplit(i interface{}) error {
+	v, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func TestValidateFeeSplit(t *testing.T) {
+	recipient := sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	half := sdk.NewDecWithPrec(5, 1)
+
+	for _, tc := range []struct {
+		desc  string
+		split FeeSplit
+		valid bool
+	}{
+		{
+			desc:  "default is valid",
+			split: DefaultParams().FeeSplit,
+			valid: true,
+		},
+		{
+			desc:  "half burned, half to the recipient",
+			split: NewFeeSplit(sdk.ZeroDec(), half, sdk.ZeroDec(), recipient, half),
+			valid: true,
+		},
+		{
+			desc:  "shares not adding up to one",
+			split: NewFeeSplit(half, sdk.ZeroDec(), sdk.ZeroDec(), nil, sdk.ZeroDec()),
+			valid: false,
+		},
+		{
+			desc:  "negative share",
+			split: NewFeeSplit(sdk.NewDec(2), sdk.NewDec(-1), sdk.ZeroDec(), nil, sdk.ZeroDec()),
+			valid: false,
+		},
+		{
+			desc:  "recipient share without recipient",
+			split: NewFeeSplit(half, sdk.ZeroDec(), sdk.ZeroDec(), nil, half),
+			valid: false,
+		},
+		{
+			desc:  "missing share",
+			split: FeeSplit{CommunityPool: sdk.OneDec()},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.split.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
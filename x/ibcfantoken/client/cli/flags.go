@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagPacketTimeoutHeight = "packet-timeout-height"
+	FlagPacketTimeout       = "packet-timeout"
+)
+
+var FsPacketTimeout = flag.NewFlagSet("", flag.ContinueOnError)
+
+func init() {
+	FsPacketTimeout.String(FlagPacketTimeoutHeight, "0-0", "The height of the counterparty chain, in the {revision}-{height} format, after which the request times out, disabled when 0-0")
+	FsPacketTimeout.Duration(FlagPacketTimeout, 10*time.Minute, "The duration from now after which the request times out, disabled when 0")
+}
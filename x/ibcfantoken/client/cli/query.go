@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+// GetQueryCmd returns the query commands for the ibcfantoken module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                types.ModuleName,
+		Short:              "Querying commands for the ibcfantoken module",
+		DisableFlagParsing: true,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryController(),
+		GetCmdQueryControllers(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryController implements the query controller command.
+func GetCmdQueryController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "controller [channel-id] [denom]",
+		Short:   "Query the controller of a fantoken on a channel.",
+		Example: fmt.Sprintf("$ %s query ibcfantoken controller channel-0 <denom>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Controller(context.Background(), &types.QueryControllerRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Controller)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryControllers implements the query controllers command.
+func GetCmdQueryControllers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "controllers",
+		Short:   "Query the registered controllers.",
+		Example: fmt.Sprintf("$ %s query ibcfantoken controllers", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Controllers(context.Background(), &types.QueryControllersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "controllers")
+
+	return cmd
+}
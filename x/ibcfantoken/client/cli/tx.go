@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+// NewTxCmd returns the transaction commands for the ibcfantoken module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC fantoken transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdRegisterController(),
+		GetCmdMint(),
+		GetCmdBurn(),
+	)
+
+	return txCmd
+}
+
+// GetCmdRegisterController implements the register controller command
+func GetCmdRegisterController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-controller [denom] [channel-id] [controller] [mint-cap]",
+		Short: "Allow an address of the counterparty chain of the channel to mint the fantoken up to the mint cap.",
+		Example: fmt.Sprintf(
+			"$ %s tx ibcfantoken register-controller <denom> channel-0 <controller-address> 1000000 "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mintCap, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("failed to parse mint cap: %s", args[3])
+			}
+
+			msg := types.NewMsgRegisterController(clientCtx.GetFromAddress(), args[0], args[1], args[2], mintCap)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMint implements the mint command
+func GetCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [src-channel] [denom] [amount] [receiver]",
+		Short: "Mint the fantoken of the counterparty chain, receiving its vouchers once acknowledged.",
+		Example: fmt.Sprintf(
+			"$ %s tx ibcfantoken mint channel-0 <denom> 1000 <receiver-address> "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to parse amount: %s", args[2])
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parsePacketTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), args[0], args[1], amount, args[3], timeoutHeight, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsPacketTimeout)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBurn implements the burn command
+func GetCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [src-channel] [denom] [amount]",
+		Short: "Burn the vouchers of the fantoken of the counterparty chain, and the escrowed fantokens.",
+		Example: fmt.Sprintf(
+			"$ %s tx ibcfantoken burn channel-0 <denom> 1000 "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("failed to parse amount: %s", args[2])
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parsePacketTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), args[0], args[1], amount, timeoutHeight, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsPacketTimeout)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parsePacketTimeout(cmd *cobra.Command) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeout, err := cmd.Flags().GetDuration(FlagPacketTimeout)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	var timeoutTimestamp uint64
+	if timeout > 0 {
+		timeoutTimestamp = uint64(time.Now().Add(timeout).UnixNano())
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
@@ -0,0 +1,36 @@
+package ibcfantoken
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+// InitGenesis stores the genesis state and binds the port
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetPort(ctx, data.PortId)
+
+	// the port is only bound if it is not already, as in the case of the
+	// chains exporting and importing the genesis
+	if !k.IsBound(ctx, data.PortId) {
+		if err := k.BindPort(ctx, data.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, controller := range data.Controllers {
+		k.SetController(ctx, controller)
+	}
+}
+
+// ExportGenesis outputs the genesis state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetControllers(ctx))
+}
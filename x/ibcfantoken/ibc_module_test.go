@@ -0,0 +1,295 @@
+package ibcfantoken_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdksimapp "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	simapp "github.com/bitsongofficial/go-bitsong/app"
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken"
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := simapp.NewBitsongApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5,
+		simapp.MakeEncodingConfig(), sdksimapp.EmptyAppOptions{},
+	)
+	return app, simapp.NewDefaultGenesisState()
+}
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainA issues the fantoken, chainB controls it
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+
+	denom   string
+	voucher string
+}
+
+func (suite *IBCModuleTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointA.ChannelConfig.Version = types.Version
+	suite.path.EndpointB.ChannelConfig.Version = types.Version
+	suite.coordinator.Setup(suite.path)
+
+	suite.denom = suite.issueFanToken()
+	suite.voucher = types.GetVoucherDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.denom)
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+func getApp(chain *ibctesting.TestChain) *simapp.BitsongApp {
+	return chain.App.(*simapp.BitsongApp)
+}
+
+// issueFanToken issues on chainA a fantoken whose minter is the sender account
+func (suite *IBCModuleTestSuite) issueFanToken() string {
+	app := getApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	params := app.FanTokenKeeper.GetParamSet(ctx)
+	params.IssueFee = nil
+	params.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, params)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", sdk.NewInt(1_000_000), sender, sender, 0, nil, "")
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	return denom
+}
+
+// registerController registers the sender account of chainB as controller of
+// the fantoken on the channel of chainA
+func (suite *IBCModuleTestSuite) registerController(mintCap sdk.Int) {
+	msg := types.NewMsgRegisterController(
+		suite.chainA.SenderAccount.GetAddress(), suite.denom, suite.path.EndpointA.ChannelID,
+		suite.chainB.SenderAccount.GetAddress().String(), mintCap,
+	)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+}
+
+// send delivers the msg on chainB and returns the packet it sends
+func (suite *IBCModuleTestSuite) send(msg sdk.Msg) channeltypes.Packet {
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// relay relays the packet of chainB to chainA, and its acknowledgement back
+func (suite *IBCModuleTestSuite) relay(packet channeltypes.Packet) channeltypes.Acknowledgement {
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	res, err := suite.path.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.EndpointB.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(types.PacketCdc.UnmarshalJSON(bz, &ack))
+
+	return ack
+}
+
+func (suite *IBCModuleTestSuite) mint(amount int64) channeltypes.Packet {
+	return suite.send(types.NewMsgMint(
+		suite.chainB.SenderAccount.GetAddress(), suite.path.EndpointB.ChannelID, suite.denom, sdk.NewInt(amount),
+		suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 1000), 0,
+	))
+}
+
+func (suite *IBCModuleTestSuite) burn(amount int64, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	return suite.send(types.NewMsgBurn(
+		suite.chainB.SenderAccount.GetAddress(), suite.path.EndpointB.ChannelID, suite.denom, sdk.NewInt(amount),
+		timeoutHeight, 0,
+	))
+}
+
+func (suite *IBCModuleTestSuite) escrowBalance() sdk.Int {
+	escrow := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	return getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, suite.denom).Amount
+}
+
+func (suite *IBCModuleTestSuite) voucherBalance() sdk.Int {
+	return getApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), suite.voucher).Amount
+}
+
+func (suite *IBCModuleTestSuite) minted() sdk.Int {
+	controller, found := getApp(suite.chainA).IBCFanTokenKeeper.GetController(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, suite.denom)
+	suite.Require().True(found)
+	return controller.Minted
+}
+
+func (suite *IBCModuleTestSuite) TestMintAndBurn() {
+	suite.registerController(sdk.NewInt(1000))
+
+	// the vouchers are minted once the fantokens are escrowed
+	packet := suite.mint(400)
+	suite.Require().True(suite.voucherBalance().IsZero())
+
+	ack := suite.relay(packet)
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.voucherBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.minted())
+
+	// the escrowed fantokens are burned with the vouchers
+	packet = suite.burn(150, clienttypes.NewHeight(0, 1000))
+	suite.Require().Equal(sdk.NewInt(250), suite.voucherBalance())
+
+	ack = suite.relay(packet)
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(sdk.NewInt(250), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewInt(250), suite.voucherBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.minted())
+
+	supply := getApp(suite.chainA).BankKeeper.GetSupply(suite.chainA.GetContext(), suite.denom)
+	suite.Require().Equal(sdk.NewInt(250), supply.Amount)
+}
+
+func (suite *IBCModuleTestSuite) TestMintCap() {
+	suite.registerController(sdk.NewInt(500))
+
+	suite.Require().True(suite.relay(suite.mint(400)).Success())
+
+	// the request exceeding the cap is rejected without state changes
+	ack := suite.relay(suite.mint(101))
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.voucherBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.minted())
+
+	// the minter raises the cap, keeping the minted amount
+	suite.registerController(sdk.NewInt(600))
+	suite.Require().Equal(sdk.NewInt(400), suite.minted())
+
+	suite.Require().True(suite.relay(suite.mint(101)).Success())
+	suite.Require().Equal(sdk.NewInt(501), suite.voucherBalance())
+	suite.Require().Equal(sdk.NewInt(501), suite.minted())
+}
+
+func (suite *IBCModuleTestSuite) TestMintFee() {
+	app := getApp(suite.chainA)
+	minter := suite.chainA.SenderAccount.GetAddress()
+
+	params := app.FanTokenKeeper.GetParamSet(suite.chainA.GetContext())
+	params.MintFee = []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)}
+	app.FanTokenKeeper.SetParamSet(suite.chainA.GetContext(), params)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.registerController(sdk.NewInt(1000))
+	balance := app.BankKeeper.GetBalance(suite.chainA.GetContext(), minter, sdk.DefaultBondDenom)
+
+	// the minter does not pay the mint fee of the remote mints
+	suite.Require().True(suite.relay(suite.mint(400)).Success())
+	suite.Require().Equal(sdk.NewInt(400), suite.escrowBalance())
+	suite.Require().Equal(balance, app.BankKeeper.GetBalance(suite.chainA.GetContext(), minter, sdk.DefaultBondDenom))
+}
+
+func (suite *IBCModuleTestSuite) TestUnregisteredController() {
+	// no controller
+	ack := suite.relay(suite.mint(100))
+	suite.Require().False(ack.Success())
+	suite.Require().True(suite.escrowBalance().IsZero())
+	suite.Require().True(suite.voucherBalance().IsZero())
+
+	// another controller
+	msg := types.NewMsgRegisterController(
+		suite.chainA.SenderAccount.GetAddress(), suite.denom, suite.path.EndpointA.ChannelID,
+		suite.chainA.SenderAccount.GetAddress().String(), sdk.NewInt(1000),
+	)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	ack = suite.relay(suite.mint(100))
+	suite.Require().False(ack.Success())
+	suite.Require().True(suite.voucherBalance().IsZero())
+	suite.Require().True(suite.minted().IsZero())
+
+	// only the minter registers the controllers
+	err = getApp(suite.chainA).IBCFanTokenKeeper.RegisterController(
+		suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), suite.denom,
+		suite.path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), sdk.NewInt(1000),
+	)
+	suite.Require().ErrorIs(err, fantokentypes.ErrInvalidMinter)
+}
+
+func (suite *IBCModuleTestSuite) TestRefund() {
+	suite.registerController(sdk.NewInt(1000))
+	suite.Require().True(suite.relay(suite.mint(400)).Success())
+
+	// the vouchers of the failed burn are refunded
+	escrow := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	app := getApp(suite.chainA)
+	suite.Require().NoError(app.BankKeeper.SendCoins(
+		suite.chainA.GetContext(), escrow, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(300))),
+	))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	packet := suite.burn(200, clienttypes.NewHeight(0, 1000))
+	suite.Require().Equal(sdk.NewInt(200), suite.voucherBalance())
+
+	ack := suite.relay(packet)
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(sdk.NewInt(100), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.voucherBalance())
+
+	// the vouchers of the timed out burn are refunded
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainA.GetContext().BlockHeight())+1)
+	packet = suite.burn(50, timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(350), suite.voucherBalance())
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 3)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.TimeoutPacket(packet))
+	suite.Require().Equal(sdk.NewInt(100), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewInt(400), suite.voucherBalance())
+}
+
+func (suite *IBCModuleTestSuite) TestGenesis() {
+	suite.registerController(sdk.NewInt(1000))
+	suite.Require().True(suite.relay(suite.mint(400)).Success())
+
+	app := getApp(suite.chainA)
+	genesis := ibcfantoken.ExportGenesis(suite.chainA.GetContext(), app.IBCFanTokenKeeper)
+	suite.Require().Equal(types.PortID, genesis.PortId)
+	suite.Require().Len(genesis.Controllers, 1)
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesis.Controllers[0].Address)
+	suite.Require().Equal(sdk.NewInt(400), genesis.Controllers[0].Minted)
+	suite.Require().NoError(genesis.Validate())
+}
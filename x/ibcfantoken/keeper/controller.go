@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+// RegisterController allows the controller, an address of the counterparty
+// chain of the channel, to mint the fantoken up to the mint cap. The minter of
+// the fantoken registers the controller, and can update the mint cap of a
+// registered controller without resetting its minted amount
+func (k Keeper) RegisterController(ctx sdk.Context, minter sdk.AccAddress, denom, channelID, address string, mintCap sdk.Int) error {
+	fantoken, err := k.fantokenKeeper.GetFanToken(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if minter.String() != fantoken.Minter {
+		return sdkerrors.Wrapf(fantokentypes.ErrInvalidMinter, "the address %s is not the minter of the fantoken %s", minter.String(), denom)
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", k.GetPort(ctx), channelID)
+	}
+
+	controller := types.NewController(channelID, denom, address, mintCap)
+	if registered, found := k.GetController(ctx, channelID, denom); found {
+		controller.Minted = registered.Minted
+	}
+
+	if err := controller.Validate(); err != nil {
+		return err
+	}
+
+	k.SetController(ctx, controller)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRegisterController{
+		ChannelId:  channelID,
+		Denom:      denom,
+		Controller: address,
+		MintCap:    mintCap,
+	})
+}
+
+// GetController returns the controller of the fantoken on the channel
+func (k Keeper) GetController(ctx sdk.Context, channelID, denom string) (controller types.Controller, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyController(channelID, denom))
+	if bz == nil {
+		return controller, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &controller)
+	return controller, true
+}
+
+// SetController stores the controller
+func (k Keeper) SetController(ctx sdk.Context, controller types.Controller) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&controller)
+	store.Set(types.KeyController(controller.ChannelId, controller.Denom), bz)
+}
+
+// GetControllers returns all the controllers
+func (k Keeper) GetControllers(ctx sdk.Context) (controllers []types.Controller) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixController)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var controller types.Controller
+		k.cdc.MustUnmarshal(iterator.Value(), &controller)
+		controllers = append(controllers, controller)
+	}
+
+	return controllers
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Controller returns the controller of a fantoken on a channel
+func (k Keeper) Controller(c context.Context, req *types.QueryControllerRequest) (*types.QueryControllerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	controller, found := k.GetController(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrControllerNotFound, "no controller of %s on %s", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryControllerResponse{Controller: controller}, nil
+}
+
+// Controllers returns all the controllers
+func (k Keeper) Controllers(c context.Context, req *types.QueryControllersRequest) (*types.QueryControllersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var controllers []types.Controller
+
+	store := ctx.KVStore(k.storeKey)
+	controllerStore := prefix.NewStore(store, types.PrefixController)
+
+	pageRes, err := query.Paginate(controllerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var controller types.Controller
+		k.cdc.MustUnmarshal(value, &controller)
+		controllers = append(controllers, controller)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryControllersResponse{Controllers: controllers, Pagination: pageRes}, nil
+}
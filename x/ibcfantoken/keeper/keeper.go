@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+type Keeper struct {
+	cdc      codec.Codec
+	storeKey sdk.StoreKey
+
+	ics4Wrapper    types.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	portKeeper     types.PortKeeper
+	scopedKeeper   capabilitykeeper.ScopedKeeper
+	bankKeeper     types.BankKeeper
+	fantokenKeeper types.FanTokenKeeper
+}
+
+func NewKeeper(
+	cdc codec.Codec,
+	key sdk.StoreKey,
+	ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+	ak types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	fantokenKeeper types.FanTokenKeeper,
+) Keeper {
+	// ensure the module account minting and burning the vouchers is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the " + types.ModuleName + " module account has not been set")
+	}
+
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		portKeeper:     portKeeper,
+		scopedKeeper:   scopedKeeper,
+		bankKeeper:     bankKeeper,
+		fantokenKeeper: fantokenKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("go-bitsong/%s", types.ModuleName))
+}
+
+// IsBound checks if the module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the module to the port, used in InitGenesis
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port ID of the module
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the port ID of the module, used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability allows the module to claim a capability that the IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
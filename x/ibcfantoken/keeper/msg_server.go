@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ibcfantoken MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (m msgServer) RegisterController(goCtx context.Context, msg *types.MsgRegisterController) (*types.MsgRegisterControllerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RegisterController(ctx, minter, msg.Denom, msg.ChannelId, msg.Controller, msg.MintCap); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterControllerResponse{}, nil
+}
+
+func (m msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := m.Keeper.SendMint(ctx, sender, msg.SourceChannel, msg.Denom, msg.Amount, receiver, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{Sequence: sequence}, nil
+}
+
+func (m msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := m.Keeper.SendBurn(ctx, sender, msg.SourceChannel, msg.Denom, msg.Amount, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{Sequence: sequence}, nil
+}
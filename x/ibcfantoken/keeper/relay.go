@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/bitsongofficial/go-bitsong/x/ibcfantoken/types"
+)
+
+// SendMint sends the request of the controller to mint the fantoken of the
+// counterparty chain, whose vouchers are minted to the receiver once the
+// request is acknowledged
+func (k Keeper) SendMint(ctx sdk.Context, sender sdk.AccAddress, sourceChannel, denom string, amount sdk.Int, receiver sdk.AccAddress, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) (uint64, error) {
+	data := types.NewMintPacketData(denom, amount, sender.String(), receiver.String())
+	return k.sendPacket(ctx, sourceChannel, data, timeoutHeight, timeoutTimestamp)
+}
+
+// SendBurn burns the vouchers of the fantoken of the counterparty chain, and
+// sends the request to burn the escrowed fantokens. The vouchers are refunded
+// when the request fails or times out
+func (k Keeper) SendBurn(ctx sdk.Context, sender sdk.AccAddress, sourceChannel, denom string, amount sdk.Int, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) (uint64, error) {
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GetVoucherDenom(k.GetPort(ctx), sourceChannel, denom), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, vouchers); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return 0, err
+	}
+
+	data := types.NewBurnPacketData(denom, amount, sender.String())
+	return k.sendPacket(ctx, sourceChannel, data, timeoutHeight, timeoutTimestamp)
+}
+
+func (k Keeper) sendPacket(ctx sdk.Context, sourceChannel string, data types.FanTokenPacketData, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) (uint64, error) {
+	sourcePort := k.GetPort(ctx)
+
+	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", sourcePort, sourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		sourcePort, sourceChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	)
+
+	return sequence, k.ics4Wrapper.SendPacket(ctx, channelCap, packet)
+}
+
+// OnRecvPacket executes the request on the chain where the fantoken is
+// issued. The fantokens minted by the controller are escrowed, and the
+// escrowed fantokens are burned by the burn requests
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FanTokenPacketData) error {
+	escrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+
+	switch request := data.Packet.(type) {
+	case *types.FanTokenPacketData_Mint:
+		controller, found := k.GetController(ctx, packet.GetDestChannel(), request.Mint.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrControllerNotFound, "no controller of %s on %s", request.Mint.Denom, packet.GetDestChannel())
+		}
+
+		if controller.Address != request.Mint.Sender {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "the address %s is not the controller of %s on %s", request.Mint.Sender, request.Mint.Denom, packet.GetDestChannel())
+		}
+
+		minted := controller.Minted.Add(request.Mint.Amount)
+		if minted.GT(controller.MintCap) {
+			return sdkerrors.Wrapf(types.ErrMintCapExceeded, "minted %s, mint cap %s", minted, controller.MintCap)
+		}
+
+		fantoken, err := k.fantokenKeeper.GetFanToken(ctx, request.Mint.Denom)
+		if err != nil {
+			return err
+		}
+
+		// the fantoken is minted as if the minter signed, the mint fee is not
+		// charged since the minter did not request the remote mint
+		coin := sdk.NewCoin(request.Mint.Denom, request.Mint.Amount)
+		if err := k.fantokenKeeper.MintWithoutFee(ctx, fantoken.GetMinter(), escrow, coin); err != nil {
+			return err
+		}
+
+		controller.Minted = minted
+		k.SetController(ctx, controller)
+
+		return nil
+
+	case *types.FanTokenPacketData_Burn:
+		coins := sdk.NewCoins(sdk.NewCoin(request.Burn.Denom, request.Burn.Amount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, escrow, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "unknown packet type %T", data.Packet)
+	}
+}
+
+// OnAcknowledgementPacket mints the vouchers of the acknowledged mint
+// requests, and refunds the vouchers of the failed burn requests
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FanTokenPacketData, ack channeltypes.Acknowledgement) error {
+	if !ack.Success() {
+		return k.refundPacket(ctx, packet, data)
+	}
+
+	request, ok := data.Packet.(*types.FanTokenPacketData_Mint)
+	if !ok {
+		return nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(request.Mint.Receiver)
+	if err != nil {
+		return err
+	}
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GetVoucherDenom(packet.GetSourcePort(), packet.GetSourceChannel(), request.Mint.Denom), request.Mint.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, vouchers)
+}
+
+// OnTimeoutPacket refunds the vouchers of the burn requests timed out
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FanTokenPacketData) error {
+	return k.refundPacket(ctx, packet, data)
+}
+
+// refundPacket mints back the vouchers burned by a burn request to its
+// sender, the mint requests have nothing to refund
+func (k Keeper) refundPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FanTokenPacketData) error {
+	request, ok := data.Packet.(*types.FanTokenPacketData_Burn)
+	if !ok {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(request.Burn.Sender)
+	if err != nil {
+		return err
+	}
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GetVoucherDenom(packet.GetSourcePort(), packet.GetSourceChannel(), request.Burn.Denom), request.Burn.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers)
+}
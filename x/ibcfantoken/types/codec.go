@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+
+	// PacketCdc encodes the packet data, as the proto JSON of the ICS-20 packets
+	PacketCdc = codec.NewProtoCodec(types.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterController{}, "go-bitsong/ibcfantoken/MsgRegisterController", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "go-bitsong/ibcfantoken/MsgMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "go-bitsong/ibcfantoken/MsgBurn", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterController{},
+		&MsgMint{},
+		&MsgBurn{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
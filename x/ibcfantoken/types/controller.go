@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// NewController creates a new Controller
+func NewController(channelID, denom, address string, mintCap sdk.Int) Controller {
+	return Controller{
+		ChannelId: channelID,
+		Denom:     denom,
+		Address:   address,
+		MintCap:   mintCap,
+		Minted:    sdk.ZeroInt(),
+	}
+}
+
+// Validate validates the controller
+func (c Controller) Validate() error {
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return err
+	}
+
+	if err := fantokentypes.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateControllerAddress(c.Address); err != nil {
+		return err
+	}
+
+	if c.MintCap.IsNil() || c.MintCap.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidController, "invalid mint cap %s", c.MintCap)
+	}
+
+	if c.Minted.IsNil() || c.Minted.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidController, "invalid minted amount %s", c.Minted)
+	}
+
+	return nil
+}
+
+// ValidateControllerAddress checks the address of a controller, which is an
+// address of the counterparty chain and cannot be decoded with the prefix of
+// the chain
+func ValidateControllerAddress(address string) error {
+	if len(strings.TrimSpace(address)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidController, "empty controller address")
+	}
+
+	return nil
+}
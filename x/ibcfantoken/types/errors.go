@@ -0,0 +1,11 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 1, "invalid ibcfantoken version")
+	ErrInvalidPacket      = sdkerrors.Register(ModuleName, 2, "invalid packet")
+	ErrControllerNotFound = sdkerrors.Register(ModuleName, 3, "controller not found")
+	ErrInvalidController  = sdkerrors.Register(ModuleName, 4, "invalid controller")
+	ErrMintCapExceeded    = sdkerrors.Register(ModuleName, 5, "mint cap exceeded")
+)
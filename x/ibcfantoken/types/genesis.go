@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(portID string, controllers []Controller) *GenesisState {
+	return &GenesisState{
+		PortId:      portID,
+		Controllers: controllers,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the ibcfantoken module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, nil)
+}
+
+// Validate validates the provided genesis state to ensure the
+// expected invariants holds.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, controller := range gs.Controllers {
+		if err := controller.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyController(controller.ChannelId, controller.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate controller of %s on %s", controller.Denom, controller.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,1394 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bitsong/ibcfantoken/v1beta1/ibcfantoken.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Controller is an account of a counterparty chain allowed to mint a fantoken
+// through an IBC channel
+type Controller struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// denom of the fantoken
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address of the controller on the counterparty chain
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// mint_cap is the maximum amount of fantoken minted through the channel
+	MintCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=mint_cap,json=mintCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mint_cap" yaml:"mint_cap"`
+	// minted is the amount of fantoken already minted through the channel
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+}
+
+func (m *Controller) Reset()         { *m = Controller{} }
+func (m *Controller) String() string { return proto.CompactTextString(m) }
+func (*Controller) ProtoMessage()    {}
+func (*Controller) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1b62b96a37f13fc, []int{0}
+}
+func (m *Controller) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Controller) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Controller.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Controller) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Controller.Merge(m, src)
+}
+func (m *Controller) XXX_Size() int {
+	return m.Size()
+}
+func (m *Controller) XXX_DiscardUnknown() {
+	xxx_messageInfo_Controller.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Controller proto.InternalMessageInfo
+
+// FanTokenPacketData is the packet data of the ibcfantoken application
+type FanTokenPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*FanTokenPacketData_Mint
+	//	*FanTokenPacketData_Burn
+	Packet isFanTokenPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *FanTokenPacketData) Reset()         { *m = FanTokenPacketData{} }
+func (m *FanTokenPacketData) String() string { return proto.CompactTextString(m) }
+func (*FanTokenPacketData) ProtoMessage()    {}
+func (*FanTokenPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1b62b96a37f13fc, []int{1}
+}
+func (m *FanTokenPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FanTokenPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FanTokenPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FanTokenPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanTokenPacketData.Merge(m, src)
+}
+func (m *FanTokenPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *FanTokenPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanTokenPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanTokenPacketData proto.InternalMessageInfo
+
+type isFanTokenPacketData_Packet interface {
+	isFanTokenPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FanTokenPacketData_Mint struct {
+	Mint *MintPacketData `protobuf:"bytes,1,opt,name=mint,proto3,oneof" json:"mint,omitempty"`
+}
+type FanTokenPacketData_Burn struct {
+	Burn *BurnPacketData `protobuf:"bytes,2,opt,name=burn,proto3,oneof" json:"burn,omitempty"`
+}
+
+func (*FanTokenPacketData_Mint) isFanTokenPacketData_Packet() {}
+func (*FanTokenPacketData_Burn) isFanTokenPacketData_Packet() {}
+
+func (m *FanTokenPacketData) GetPacket() isFanTokenPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *FanTokenPacketData) GetMint() *MintPacketData {
+	if x, ok := m.GetPacket().(*FanTokenPacketData_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (m *FanTokenPacketData) GetBurn() *BurnPacketData {
+	if x, ok := m.GetPacket().(*FanTokenPacketData_Burn); ok {
+		return x.Burn
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FanTokenPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FanTokenPacketData_Mint)(nil),
+		(*FanTokenPacketData_Burn)(nil),
+	}
+}
+
+// MintPacketData requests to mint a fantoken on the chain where it is issued,
+// the minted fantokens are escrowed there and their vouchers are minted to
+// the receiver on the sending chain
+type MintPacketData struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// sender is the controller
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver of the vouchers on the sending chain
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MintPacketData) Reset()         { *m = MintPacketData{} }
+func (m *MintPacketData) String() string { return proto.CompactTextString(m) }
+func (*MintPacketData) ProtoMessage()    {}
+func (*MintPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1b62b96a37f13fc, []int{2}
+}
+func (m *MintPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPacketData.Merge(m, src)
+}
+func (m *MintPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPacketData proto.InternalMessageInfo
+
+// BurnPacketData requests to burn the escrowed fantokens whose vouchers have
+// been burned on the sending chain
+type BurnPacketData struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Sender string                                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *BurnPacketData) Reset()         { *m = BurnPacketData{} }
+func (m *BurnPacketData) String() string { return proto.CompactTextString(m) }
+func (*BurnPacketData) ProtoMessage()    {}
+func (*BurnPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1b62b96a37f13fc, []int{3}
+}
+func (m *BurnPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnPacketData.Merge(m, src)
+}
+func (m *BurnPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnPacketData proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Controller)(nil), "bitsong.ibcfantoken.v1beta1.Controller")
+	proto.RegisterType((*FanTokenPacketData)(nil), "bitsong.ibcfantoken.v1beta1.FanTokenPacketData")
+	proto.RegisterType((*MintPacketData)(nil), "bitsong.ibcfantoken.v1beta1.MintPacketData")
+	proto.RegisterType((*BurnPacketData)(nil), "bitsong.ibcfantoken.v1beta1.BurnPacketData")
+}
+
+func init() {
+	proto.RegisterFile("bitsong/ibcfantoken/v1beta1/ibcfantoken.proto", fileDescriptor_a1b62b96a37f13fc)
+}
+
+var fileDescriptor_a1b62b96a37f13fc = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xce, 0xac, 0x6d, 0xba, 0x7d, 0x42, 0xc5, 0xa1, 0x4a, 0xa8, 0x90, 0x95, 0x1c, 0x44, 0x90,
+	0x26, 0x54, 0xbd, 0xe8, 0xad, 0x5b, 0x59, 0xec, 0x41, 0x90, 0x20, 0x08, 0x22, 0x94, 0x49, 0x32,
+	0x9b, 0x0e, 0x9b, 0xcc, 0x84, 0x99, 0xd9, 0x62, 0xff, 0x80, 0x67, 0x8f, 0x9e, 0xc5, 0x1f, 0xb3,
+	0xc7, 0x1e, 0xc5, 0xc3, 0xa2, 0xbb, 0xff, 0xa0, 0xbf, 0x40, 0x66, 0x32, 0xed, 0x6e, 0x2e, 0xa2,
+	0x5e, 0x3c, 0xe5, 0x7d, 0x6f, 0xde, 0xf7, 0xbd, 0x97, 0xf7, 0xf1, 0x60, 0x3f, 0x63, 0x5a, 0x09,
+	0x5e, 0x26, 0x2c, 0xcb, 0xc7, 0x84, 0x6b, 0x31, 0xa1, 0x3c, 0x39, 0x3b, 0xc8, 0xa8, 0x26, 0x07,
+	0xeb, 0xb9, 0xb8, 0x91, 0x42, 0x0b, 0x7c, 0xcf, 0x95, 0xc7, 0xeb, 0x4f, 0xae, 0x7c, 0x6f, 0xb7,
+	0x14, 0xa5, 0xb0, 0x75, 0x89, 0x89, 0x5a, 0x4a, 0xf4, 0xb9, 0x07, 0x70, 0x24, 0xb8, 0x96, 0xa2,
+	0xaa, 0xa8, 0xc4, 0x4f, 0x01, 0xf2, 0x53, 0xc2, 0x39, 0xad, 0x4e, 0x58, 0x11, 0xa0, 0xfb, 0xe8,
+	0xe1, 0xf6, 0xf0, 0xce, 0xe5, 0x7c, 0x70, 0xfb, 0x9c, 0xd4, 0xd5, 0xf3, 0x68, 0xf5, 0x16, 0xa5,
+	0xdb, 0x0e, 0x1c, 0x17, 0x78, 0x17, 0x36, 0x0b, 0xca, 0x45, 0x1d, 0xf4, 0x0c, 0x21, 0x6d, 0x01,
+	0x0e, 0x60, 0x8b, 0x14, 0x85, 0xa4, 0x4a, 0x05, 0x37, 0x6c, 0xfe, 0x0a, 0xe2, 0xf7, 0xd0, 0xaf,
+	0x19, 0xd7, 0x27, 0x39, 0x69, 0x82, 0x0d, 0xdb, 0xe3, 0x70, 0x36, 0x1f, 0x78, 0xdf, 0xe7, 0x83,
+	0x07, 0x25, 0xd3, 0xa7, 0xd3, 0x2c, 0xce, 0x45, 0x9d, 0xe4, 0x42, 0xd5, 0x42, 0xb9, 0xcf, 0xbe,
+	0x2a, 0x26, 0x89, 0x3e, 0x6f, 0xa8, 0x8a, 0x8f, 0xb9, 0xbe, 0x9c, 0x0f, 0x6e, 0xb5, 0x13, 0x5d,
+	0xe9, 0x44, 0xe9, 0x96, 0x09, 0x8f, 0x48, 0x83, 0x47, 0xe0, 0x9b, 0x90, 0x16, 0xc1, 0xa6, 0xd5,
+	0x8e, 0xff, 0x4e, 0x3b, 0x75, 0xec, 0xe8, 0x2b, 0x02, 0x3c, 0x22, 0xfc, 0x8d, 0xd9, 0xe2, 0x6b,
+	0x92, 0x4f, 0xa8, 0x7e, 0x41, 0x34, 0xc1, 0x87, 0xb0, 0x61, 0x0a, 0xec, 0x72, 0x6e, 0x3e, 0x7e,
+	0x14, 0xff, 0x66, 0xe7, 0xf1, 0x2b, 0xc6, 0xf5, 0x8a, 0xfa, 0xd2, 0x4b, 0x2d, 0xd5, 0x48, 0x64,
+	0x53, 0xc9, 0x83, 0xde, 0x1f, 0x48, 0x0c, 0xa7, 0x92, 0x77, 0x25, 0x0c, 0x75, 0xd8, 0x07, 0xbf,
+	0xb1, 0xd9, 0xe8, 0x0b, 0x82, 0x9d, 0x6e, 0x9f, 0x95, 0x1f, 0x68, 0xdd, 0x8f, 0x11, 0xf8, 0xa4,
+	0x16, 0x53, 0xae, 0x83, 0xde, 0xbf, 0xed, 0xa5, 0x65, 0xe3, 0xbb, 0xe0, 0x2b, 0xca, 0x0b, 0x2a,
+	0x9d, 0xad, 0x0e, 0xe1, 0x3d, 0xe8, 0x4b, 0x9a, 0x53, 0x76, 0x46, 0x65, 0xeb, 0x6a, 0x7a, 0x8d,
+	0xa3, 0x8f, 0x08, 0x76, 0xba, 0x7f, 0xf2, 0x7f, 0x86, 0x1c, 0xbe, 0x9d, 0xfd, 0x0c, 0xbd, 0xd9,
+	0x22, 0x44, 0x17, 0x8b, 0x10, 0xfd, 0x58, 0x84, 0xe8, 0xd3, 0x32, 0xf4, 0x2e, 0x96, 0xa1, 0xf7,
+	0x6d, 0x19, 0x7a, 0xef, 0x9e, 0xad, 0x75, 0x71, 0xa6, 0x88, 0xf1, 0x98, 0xe5, 0x8c, 0x54, 0x49,
+	0x29, 0xae, 0xaf, 0xf1, 0x43, 0xe7, 0x1e, 0x6d, 0xf3, 0xcc, 0xb7, 0xf7, 0xf4, 0xe4, 0xd7, 0x00,
+	0x54, 0xa3, 0x61, 0xa7, 0xb3, 0x03, 0x00, 0x00,
+}
+
+func (m *Controller) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Controller) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Controller) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MintCap.Size()
+		i -= size
+		if _, err := m.MintCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FanTokenPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FanTokenPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FanTokenPacketData_Mint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanTokenPacketData_Mint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mint != nil {
+		{
+			size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FanTokenPacketData_Burn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanTokenPacketData_Burn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Burn != nil {
+		{
+			size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MintPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcfantoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbcfantoken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbcfantoken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Controller) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = m.MintCap.Size()
+	n += 1 + l + sovIbcfantoken(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovIbcfantoken(uint64(l))
+	return n
+}
+
+func (m *FanTokenPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *FanTokenPacketData_Mint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mint != nil {
+		l = m.Mint.Size()
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	return n
+}
+func (m *FanTokenPacketData_Burn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Burn != nil {
+		l = m.Burn.Size()
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	return n
+}
+func (m *MintPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIbcfantoken(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIbcfantoken(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIbcfantoken(uint64(l))
+	}
+	return n
+}
+
+func sovIbcfantoken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbcfantoken(x uint64) (n int) {
+	return sovIbcfantoken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Controller) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfantoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Controller: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Controller: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfantoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FanTokenPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfantoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FanTokenPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FanTokenPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MintPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &FanTokenPacketData_Mint{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BurnPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &FanTokenPacketData_Burn{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfantoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfantoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfantoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcfantoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcfantoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcfantoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbcfantoken(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbcfantoken
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcfantoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbcfantoken
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbcfantoken
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbcfantoken
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbcfantoken        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbcfantoken          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbcfantoken = fmt.Errorf("proto: unexpected end of group")
+)
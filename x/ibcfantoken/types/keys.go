@@ -0,0 +1,60 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "ibcfantoken"
+
+	// StoreKey is the string store representation, which cannot start with the
+	// ibc store key
+	StoreKey string = "ftibc"
+
+	// RouterKey is the msg router key for the module
+	RouterKey string = ModuleName
+
+	// QuerierRoute is the querier route for the module
+	QuerierRoute string = ModuleName
+
+	// PortID is the default port id the module binds to
+	PortID = ModuleName
+
+	// Version defines the current version of the ibcfantoken application
+	Version = "ibcfantoken-1"
+)
+
+// Actions of the packets
+const (
+	ActionMint = "mint"
+	ActionBurn = "burn"
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+
+	// PrefixController defines a prefix for the controllers
+	PrefixController = []byte{0x02}
+)
+
+// KeyControllers returns the prefix of the controllers of the specified channel
+func KeyControllers(channelID string) []byte {
+	return append(PrefixController, address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// KeyController returns the key of the controller of the specified channel and denom
+func KeyController(channelID, denom string) []byte {
+	return append(KeyControllers(channelID), []byte(denom)...)
+}
+
+// GetVoucherDenom returns the denom of the vouchers of the fantoken of the
+// counterparty chain, minted through the specified port and channel
+func GetVoucherDenom(portID, channelID, denom string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", portID, channelID, denom)))
+	return fmt.Sprintf("%s/%X", ModuleName, hash)
+}
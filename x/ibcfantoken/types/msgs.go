@@ -0,0 +1,185 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+const (
+	// MsgRoute identifies transaction types
+	MsgRoute = "ibcfantoken"
+
+	TypeMsgRegisterController = "register_controller"
+	TypeMsgMint               = "mint"
+	TypeMsgBurn               = "burn"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterController{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+)
+
+// NewMsgRegisterController creates a new MsgRegisterController instance
+func NewMsgRegisterController(minter sdk.AccAddress, denom, channelID, controller string, mintCap sdk.Int) *MsgRegisterController {
+	return &MsgRegisterController{
+		Minter:     minter.String(),
+		Denom:      denom,
+		ChannelId:  channelID,
+		Controller: controller,
+		MintCap:    mintCap,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRegisterController) Route() string { return MsgRoute }
+
+// Type Implements Msg.
+func (msg MsgRegisterController) Type() string { return TypeMsgRegisterController }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRegisterController) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+
+	controller := NewController(msg.ChannelId, msg.Denom, msg.Controller, msg.MintCap)
+	return controller.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRegisterController) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRegisterController) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgMint creates a new MsgMint instance
+func NewMsgMint(sender sdk.AccAddress, sourceChannel, denom string, amount sdk.Int, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) *MsgMint {
+	return &MsgMint{
+		Sender:           sender.String(),
+		SourceChannel:    sourceChannel,
+		Denom:            denom,
+		Amount:           amount,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgMint) Route() string { return MsgRoute }
+
+// Type Implements Msg.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	return validateRequest(msg.SourceChannel, msg.Denom, msg.Amount, msg.TimeoutHeight, msg.TimeoutTimestamp)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMint) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgBurn creates a new MsgBurn instance
+func NewMsgBurn(sender sdk.AccAddress, sourceChannel, denom string, amount sdk.Int, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) *MsgBurn {
+	return &MsgBurn{
+		Sender:           sender.String(),
+		SourceChannel:    sourceChannel,
+		Denom:            denom,
+		Amount:           amount,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return MsgRoute }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return validateRequest(msg.SourceChannel, msg.Denom, msg.Amount, msg.TimeoutHeight, msg.TimeoutTimestamp)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func validateRequest(sourceChannel, denom string, amount sdk.Int, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) error {
+	if err := host.ChannelIdentifierValidator(sourceChannel); err != nil {
+		return sdkerrors.Wrapf(err, "invalid source channel ID")
+	}
+
+	if err := fantokentypes.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", amount)
+	}
+
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "the timeout height and timestamp cannot be both empty")
+	}
+
+	return nil
+}
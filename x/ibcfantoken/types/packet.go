@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	fantokentypes "github.com/bitsongofficial/go-bitsong/x/fantoken/types"
+)
+
+// NewMintPacketData creates the packet data of a mint request
+func NewMintPacketData(denom string, amount sdk.Int, sender, receiver string) FanTokenPacketData {
+	return FanTokenPacketData{
+		Packet: &FanTokenPacketData_Mint{Mint: &MintPacketData{
+			Denom:    denom,
+			Amount:   amount,
+			Sender:   sender,
+			Receiver: receiver,
+		}},
+	}
+}
+
+// NewBurnPacketData creates the packet data of a burn request
+func NewBurnPacketData(denom string, amount sdk.Int, sender string) FanTokenPacketData {
+	return FanTokenPacketData{
+		Packet: &FanTokenPacketData_Burn{Burn: &BurnPacketData{
+			Denom:  denom,
+			Amount: amount,
+			Sender: sender,
+		}},
+	}
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data
+func (p FanTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(PacketCdc.MustMarshalJSON(&p))
+}
+
+// GetAction returns the action requested by the packet
+func (p FanTokenPacketData) GetAction() string {
+	switch p.Packet.(type) {
+	case *FanTokenPacketData_Mint:
+		return ActionMint
+	case *FanTokenPacketData_Burn:
+		return ActionBurn
+	default:
+		return ""
+	}
+}
+
+// GetRequest returns the denom, the amount and the sender of the request
+func (p FanTokenPacketData) GetRequest() (denom string, amount sdk.Int, sender string) {
+	switch packet := p.Packet.(type) {
+	case *FanTokenPacketData_Mint:
+		return packet.Mint.Denom, packet.Mint.Amount, packet.Mint.Sender
+	case *FanTokenPacketData_Burn:
+		return packet.Burn.Denom, packet.Burn.Amount, packet.Burn.Sender
+	default:
+		return "", sdk.ZeroInt(), ""
+	}
+}
+
+// ValidateBasic validates the packet data
+func (p FanTokenPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *FanTokenPacketData_Mint:
+		if len(packet.Mint.Receiver) == 0 {
+			return sdkerrors.Wrap(ErrInvalidPacket, "empty receiver")
+		}
+		return validatePacket(packet.Mint.Denom, packet.Mint.Amount, packet.Mint.Sender)
+	case *FanTokenPacketData_Burn:
+		return validatePacket(packet.Burn.Denom, packet.Burn.Amount, packet.Burn.Sender)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPacket, "unknown packet type %T", p.Packet)
+	}
+}
+
+func validatePacket(denom string, amount sdk.Int, sender string) error {
+	if err := fantokentypes.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "invalid amount %s", amount)
+	}
+
+	if len(sender) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "empty sender")
+	}
+
+	return nil
+}
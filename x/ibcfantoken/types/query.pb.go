@@ -0,0 +1,1107 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bitsong/ibcfantoken/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryControllerRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryControllerRequest) Reset()         { *m = QueryControllerRequest{} }
+func (m *QueryControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerRequest) ProtoMessage()    {}
+func (*QueryControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d0e2da1c156e4df, []int{0}
+}
+func (m *QueryControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerRequest.Merge(m, src)
+}
+func (m *QueryControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryControllerRequest proto.InternalMessageInfo
+
+func (m *QueryControllerRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryControllerRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryControllerResponse struct {
+	Controller Controller `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller"`
+}
+
+func (m *QueryControllerResponse) Reset()         { *m = QueryControllerResponse{} }
+func (m *QueryControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerResponse) ProtoMessage()    {}
+func (*QueryControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d0e2da1c156e4df, []int{1}
+}
+func (m *QueryControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerResponse.Merge(m, src)
+}
+func (m *QueryControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryControllerResponse proto.InternalMessageInfo
+
+func (m *QueryControllerResponse) GetController() Controller {
+	if m != nil {
+		return m.Controller
+	}
+	return Controller{}
+}
+
+type QueryControllersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryControllersRequest) Reset()         { *m = QueryControllersRequest{} }
+func (m *QueryControllersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryControllersRequest) ProtoMessage()    {}
+func (*QueryControllersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d0e2da1c156e4df, []int{2}
+}
+func (m *QueryControllersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryControllersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllersRequest.Merge(m, src)
+}
+func (m *QueryControllersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryControllersRequest proto.InternalMessageInfo
+
+func (m *QueryControllersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryControllersResponse struct {
+	Controllers []Controller        `protobuf:"bytes,1,rep,name=controllers,proto3" json:"controllers"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryControllersResponse) Reset()         { *m = QueryControllersResponse{} }
+func (m *QueryControllersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryControllersResponse) ProtoMessage()    {}
+func (*QueryControllersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d0e2da1c156e4df, []int{3}
+}
+func (m *QueryControllersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryControllersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllersResponse.Merge(m, src)
+}
+func (m *QueryControllersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryControllersResponse proto.InternalMessageInfo
+
+func (m *QueryControllersResponse) GetControllers() []Controller {
+	if m != nil {
+		return m.Controllers
+	}
+	return nil
+}
+
+func (m *QueryControllersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryControllerRequest)(nil), "bitsong.ibcfantoken.v1beta1.QueryControllerRequest")
+	proto.RegisterType((*QueryControllerResponse)(nil), "bitsong.ibcfantoken.v1beta1.QueryControllerResponse")
+	proto.RegisterType((*QueryControllersRequest)(nil), "bitsong.ibcfantoken.v1beta1.QueryControllersRequest")
+	proto.RegisterType((*QueryControllersResponse)(nil), "bitsong.ibcfantoken.v1beta1.QueryControllersResponse")
+}
+
+func init() {
+	proto.RegisterFile("bitsong/ibcfantoken/v1beta1/query.proto", fileDescriptor_0d0e2da1c156e4df)
+}
+
+var fileDescriptor_0d0e2da1c156e4df = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0x40, 0x91, 0xfa, 0xb2, 0x59, 0x15, 0x44, 0x01, 0x8e, 0xea, 0x06, 0x5a, 0x55,
+	0xc2, 0xa6, 0x2d, 0x0c, 0x0c, 0x08, 0xa9, 0x08, 0x10, 0x43, 0x05, 0x84, 0x8d, 0x05, 0xf9, 0x2e,
+	0x8e, 0x63, 0x71, 0xf1, 0xbb, 0xc6, 0x0e, 0xa2, 0xaa, 0xba, 0xf0, 0x09, 0x90, 0xf8, 0x1a, 0x8c,
+	0xcc, 0xcc, 0x1d, 0x2b, 0xb1, 0x30, 0x21, 0x94, 0xf0, 0x1d, 0x58, 0x51, 0x7c, 0x4e, 0xee, 0xa2,
+	0xa0, 0xd0, 0x6c, 0x89, 0xfd, 0x7f, 0xff, 0xff, 0xef, 0xbd, 0x7b, 0x86, 0xad, 0x44, 0x3b, 0x8b,
+	0x46, 0x71, 0x9d, 0xa4, 0x5d, 0x61, 0x1c, 0xbe, 0x93, 0x86, 0xbf, 0xdf, 0x4d, 0xa4, 0x13, 0xbb,
+	0xfc, 0x68, 0x28, 0x07, 0xc7, 0x2c, 0x1f, 0xa0, 0x43, 0x7a, 0x3d, 0x08, 0x59, 0x45, 0xc8, 0x82,
+	0xb0, 0xb5, 0xa1, 0x50, 0xa1, 0xd7, 0xf1, 0xc9, 0xaf, 0xa2, 0xa4, 0x75, 0x43, 0x21, 0xaa, 0x4c,
+	0x72, 0x91, 0x6b, 0x2e, 0x8c, 0x41, 0x27, 0x9c, 0x46, 0x63, 0xc3, 0xed, 0x4e, 0x8a, 0xb6, 0x8f,
+	0x96, 0x27, 0xc2, 0xca, 0x22, 0x69, 0x96, 0x9b, 0x0b, 0xa5, 0x8d, 0x17, 0x07, 0xed, 0x9d, 0x65,
+	0x94, 0x55, 0x20, 0x2f, 0x8f, 0x0f, 0xe1, 0xea, 0xab, 0x89, 0xe1, 0x63, 0x34, 0x6e, 0x80, 0x59,
+	0x26, 0x07, 0x6d, 0x79, 0x34, 0x94, 0xd6, 0xd1, 0x9b, 0x00, 0x69, 0x4f, 0x18, 0x23, 0xb3, 0xb7,
+	0xba, 0xd3, 0x24, 0x9b, 0x64, 0x7b, 0xbd, 0xbd, 0x1e, 0x4e, 0x9e, 0x77, 0xe8, 0x06, 0xac, 0x75,
+	0xa4, 0xc1, 0x7e, 0xb3, 0xee, 0x6f, 0x8a, 0x3f, 0x71, 0x0f, 0xae, 0x2d, 0xd8, 0xd9, 0x1c, 0x8d,
+	0x95, 0xf4, 0x10, 0x20, 0x9d, 0x9d, 0x7a, 0xbf, 0xc6, 0xde, 0x16, 0x5b, 0x32, 0x2a, 0x56, 0x9a,
+	0x1c, 0x5c, 0x3e, 0xfb, 0x79, 0xab, 0xd6, 0xae, 0x18, 0xc4, 0x62, 0x21, 0xc9, 0x4e, 0xc9, 0x9f,
+	0x02, 0x94, 0x63, 0x09, 0x49, 0xb7, 0x59, 0x31, 0x43, 0x36, 0x99, 0x21, 0x2b, 0xbe, 0xd6, 0x34,
+	0xe7, 0xa5, 0x50, 0x32, 0xd4, 0xb6, 0x2b, 0x95, 0xf1, 0x57, 0x02, 0xcd, 0xc5, 0x8c, 0xd0, 0xce,
+	0x0b, 0x68, 0x94, 0x34, 0xb6, 0x49, 0x36, 0x2f, 0xad, 0xde, 0x4f, 0xd5, 0x81, 0x3e, 0x9b, 0xa3,
+	0xae, 0x87, 0xf9, 0xfc, 0x8f, 0xba, 0xa0, 0xa9, 0x62, 0xef, 0xfd, 0xa9, 0xc3, 0x9a, 0xc7, 0xa6,
+	0xdf, 0x08, 0x40, 0x19, 0x4a, 0xf7, 0x97, 0xd2, 0xfd, 0x7b, 0x0d, 0x5a, 0xf7, 0x56, 0x2b, 0x2a,
+	0x78, 0xe2, 0x27, 0x1f, 0xbf, 0xff, 0xfe, 0x5c, 0x7f, 0x44, 0x1f, 0xf2, 0x65, 0xeb, 0x58, 0x69,
+	0x9f, 0x9f, 0x94, 0xcb, 0x76, 0xca, 0x4f, 0xfc, 0x36, 0x9d, 0xd2, 0x2f, 0x04, 0x1a, 0x95, 0xe1,
+	0xd3, 0x95, 0x60, 0xa6, 0xfb, 0xd0, 0xba, 0xbf, 0x62, 0x55, 0xe8, 0xe1, 0xae, 0xef, 0x61, 0x87,
+	0x6e, 0x5f, 0xb4, 0x87, 0x83, 0xd7, 0x67, 0xa3, 0x88, 0x9c, 0x8f, 0x22, 0xf2, 0x6b, 0x14, 0x91,
+	0x4f, 0xe3, 0xa8, 0x76, 0x3e, 0x8e, 0x6a, 0x3f, 0xc6, 0x51, 0xed, 0xcd, 0x03, 0xa5, 0x5d, 0x6f,
+	0x98, 0xb0, 0x14, 0xfb, 0x53, 0x37, 0xec, 0x76, 0x75, 0xaa, 0x45, 0xc6, 0x15, 0xce, 0xde, 0xec,
+	0x87, 0xb9, 0x08, 0x77, 0x9c, 0x4b, 0x9b, 0x5c, 0xf1, 0x0f, 0x75, 0xff, 0xef, 0x00, 0xdb, 0x6d,
+	0x78, 0x2d, 0x7f, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Controller queries the controller of a fantoken on a channel
+	Controller(ctx context.Context, in *QueryControllerRequest, opts ...grpc.CallOption) (*QueryControllerResponse, error)
+	// Controllers queries all the controllers
+	Controllers(ctx context.Context, in *QueryControllersRequest, opts ...grpc.CallOption) (*QueryControllersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Controller(ctx context.Context, in *QueryControllerRequest, opts ...grpc.CallOption) (*QueryControllerResponse, error) {
+	out := new(QueryControllerResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.ibcfantoken.v1beta1.Query/Controller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Controllers(ctx context.Context, in *QueryControllersRequest, opts ...grpc.CallOption) (*QueryControllersResponse, error) {
+	out := new(QueryControllersResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.ibcfantoken.v1beta1.Query/Controllers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Controller queries the controller of a fantoken on a channel
+	Controller(context.Context, *QueryControllerRequest) (*QueryControllerResponse, error)
+	// Controllers queries all the controllers
+	Controllers(context.Context, *QueryControllersRequest) (*QueryControllersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Controller(ctx context.Context, req *QueryControllerRequest) (*QueryControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Controller not implemented")
+}
+func (*UnimplementedQueryServer) Controllers(ctx context.Context, req *QueryControllersRequest) (*QueryControllersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Controllers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Controller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Controller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.ibcfantoken.v1beta1.Query/Controller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Controller(ctx, req.(*QueryControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Controllers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Controllers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.ibcfantoken.v1beta1.Query/Controllers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Controllers(ctx, req.(*QueryControllersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bitsong.ibcfantoken.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Controller",
+			Handler:    _Query_Controller_Handler,
+		},
+		{
+			MethodName: "Controllers",
+			Handler:    _Query_Controllers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitsong/ibcfantoken/v1beta1/query.proto",
+}
+
+func (m *QueryControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Controller.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controllers) > 0 {
+		for iNdEx := len(m.Controllers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Controllers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Controller.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryControllersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryControllersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Controllers) > 0 {
+		for _, e := range m.Controllers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controllers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controllers = append(m.Controllers, Controller{})
+			if err := m.Controllers[len(m.Controllers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
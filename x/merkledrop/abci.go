@@ -9,10 +9,25 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	logger := keeper.Logger(ctx)
 
 	merkledropIDs := keeper.GetMerkleDropsIDByEndHeight(ctx, ctx.BlockHeight())
+	merkledropIDs = append(merkledropIDs, keeper.GetMerkleDropsIDByEndTime(ctx, ctx.BlockTime())...)
 
 	for _, merkledropID := range merkledropIDs {
-		keeper.Withdraw(ctx, merkledropID)
-		keeper.DeleteMerkledropByID(ctx, merkledropID)
+		// withdraw and delete in a cached context, so that a failure keeps the
+		// merkledrop and its coins in place, the owner can still withdraw them
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		if err := keeper.Withdraw(cacheCtx, merkledropID); err != nil {
+			logger.Error("failed to withdraw the expired merkledrop", "mdID", merkledropID, "err", err)
+			continue
+		}
+
+		if err := keeper.DeleteMerkledropByID(cacheCtx, merkledropID); err != nil {
+			logger.Error("failed to delete the expired merkledrop", "mdID", merkledropID, "err", err)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 		logger.Info("merkledrop deleted", "mdID", merkledropID)
 	}
 }
@@ -35,6 +35,13 @@ func generateMerkledrop(owner sdk.AccAddress, accs map[string]string) (*types.Ms
 	), claimInfo
 }
 
+// setCreationFee lowers the creation fee below the balances funded by the tests
+func setCreationFee(ctx sdk.Context, app *simapp.BitsongApp, fee sdk.Coin) {
+	params := app.MerkledropKeeper.GetParamSet(ctx)
+	params.CreationFee = []sdk.Coin{fee}
+	app.MerkledropKeeper.SetParamSet(ctx, params)
+}
+
 func TestExpiredMerkledropHeight(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -42,6 +49,7 @@ func TestExpiredMerkledropHeight(t *testing.T) {
 	app.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	msgSvr := keeper.NewMsgServerImpl(app.MerkledropKeeper)
+	setCreationFee(ctx, app, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
 
 	owner1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	owner2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -190,6 +198,8 @@ func TestExpiredMerkledrop(t *testing.T) {
 	app.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	msgSvr := keeper.NewMsgServerImpl(app.MerkledropKeeper)
+	creationFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000))
+	setCreationFee(ctx, app, creationFee)
 
 	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	initCoins := sdk.Coins{
@@ -224,7 +234,6 @@ func TestExpiredMerkledrop(t *testing.T) {
 	require.NotNil(t, res)
 
 	balance = app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
-	creationFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000))
 	expectedAmt := initCoins.AmountOf(sdk.DefaultBondDenom).Sub(creationFee.Amount).Sub(airdropCoin.Amount)
 	require.Equal(t, expectedAmt, balance.Amount)
 
@@ -244,3 +253,107 @@ func TestExpiredMerkledrop(t *testing.T) {
 	expectedAmt = initCoins.AmountOf(sdk.DefaultBondDenom).Sub(creationFee.Amount)
 	require.Equal(t, expectedAmt, balance.Amount)
 }
+
+func TestExpiredMerkledropPausedDenom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	msgSvr := keeper.NewMsgServerImpl(app.MerkledropKeeper)
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	fantokenParams := app.FanTokenKeeper.GetParamSet(ctx)
+	fantokenParams.IssueFee = nil
+	fantokenParams.MintFee = nil
+	app.FanTokenKeeper.SetParamSet(ctx, fantokenParams)
+
+	denom, err := app.FanTokenKeeper.Issue(ctx, "fan club token", "club", "ipfs://club", sdk.NewInt(1_000), owner, owner, 0, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, app.FanTokenKeeper.Mint(ctx, owner, owner, sdk.NewInt64Coin(denom, 1_000), ""))
+
+	creationFee := app.MerkledropKeeper.GetParamSet(ctx).CreationFee
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, creationFee))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, owner, creationFee))
+
+	endHeight := int64(100)
+	_, err = msgSvr.Create(sdk.WrapSDKContext(ctx), types.NewMsgCreate(owner, "", 1, endHeight, sdk.NewInt64Coin(denom, 1_000)))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, owner, denom).IsZero())
+
+	// the denom is paused before the merkledrop expires
+	require.NoError(t, app.FanTokenKeeper.Pause(ctx, denom, owner))
+
+	ctx = ctx.WithBlockHeight(endHeight)
+	merkledrop.EndBlocker(ctx, app.MerkledropKeeper)
+
+	// the unclaimed coins are refunded to the owner anyway
+	require.Len(t, app.MerkledropKeeper.GetAllMerkleDrops(ctx), 0)
+	require.Equal(t, sdk.NewInt(1_000), app.BankKeeper.GetBalance(ctx, owner, denom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	_, broken := keeper.AllInvariants(app.MerkledropKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func setupBenchmarkMerkledrop(b *testing.B, app *simapp.BitsongApp, ctx sdk.Context, id uint64, endHeight int64, claimed uint64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(claimed)))
+	merkledrop := types.Merkledrop{
+		Id:           id,
+		MerkleRoot:   "sdsd",
+		StartHeight:  1,
+		EndHeight:    endHeight,
+		Coins:        coins,
+		ClaimedCoins: coins,
+		LeafVersion:  types.LeafVersion2,
+		Owner:        sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+	}
+	require.NoError(b, app.MerkledropKeeper.SetMerkleDrop(ctx, merkledrop))
+
+	for index := uint64(0); index < claimed; index++ {
+		app.MerkledropKeeper.SetClaimed(ctx, id, index)
+	}
+}
+
+// BenchmarkEndBlocker measures the time and the gas spent by the EndBlocker to
+// delete an expired merkledrop with all of its indexes claimed.
+func BenchmarkEndBlocker(b *testing.B) {
+	for _, claimed := range []uint64{10_000, 100_000, 1_000_000} {
+		b.Run(fmt.Sprintf("indexes=%d", claimed), func(b *testing.B) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+			var gas sdk.Gas
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				setupBenchmarkMerkledrop(b, app, ctx, uint64(i+1), ctx.BlockHeight(), claimed)
+				gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				b.StartTimer()
+
+				merkledrop.EndBlocker(gasCtx, app.MerkledropKeeper)
+				gas += gasCtx.GasMeter().GasConsumed()
+			}
+			b.ReportMetric(float64(gas)/float64(b.N), "gas/op")
+		})
+	}
+}
+
+// BenchmarkSetClaimed measures the gas spent to check and set a claimed index
+// of a merkledrop with 10k, 100k and 1M indexes already claimed.
+func BenchmarkSetClaimed(b *testing.B) {
+	for _, claimed := range []uint64{10_000, 100_000, 1_000_000} {
+		b.Run(fmt.Sprintf("indexes=%d", claimed), func(b *testing.B) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+			setupBenchmarkMerkledrop(b, app, ctx, 1, 100, claimed)
+
+			gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				index := claimed + uint64(i)
+				if !app.MerkledropKeeper.IsClaimed(gasCtx, 1, index) {
+					app.MerkledropKeeper.SetClaimed(gasCtx, 1, index)
+				}
+			}
+			b.ReportMetric(float64(gasCtx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+		})
+	}
+}
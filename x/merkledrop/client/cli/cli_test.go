@@ -6,6 +6,7 @@ import (
 	"github.com/bitsongofficial/go-bitsong/app"
 	"github.com/bitsongofficial/go-bitsong/app/params"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/client/cli"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -14,7 +15,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
-	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -31,9 +32,17 @@ func (s *IntegrationTestSuite) SetupSuite() {
 
 	s.cfg = app.DefaultConfig()
 
+	// lower the creation fee below the balance of the validator
+	var genesisState types.GenesisState
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(s.cfg.GenesisState[types.ModuleName], &genesisState))
+	genesisState.Params.CreationFee = []sdk.Coin{sdk.NewInt64Coin(s.cfg.BondDenom, 1_000_000)}
+	genesisJSON, err := s.cfg.Codec.MarshalJSON(&genesisState)
+	s.Require().NoError(err)
+	s.cfg.GenesisState[types.ModuleName] = genesisJSON
+
 	s.network = network.New(s.T(), s.cfg)
 
-	_, err := s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 }
 
@@ -92,8 +101,10 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMerkledrop() {
 	clientCtx := val.ClientCtx
 
 	//merkleRoot := "3452cae72dab475d017c1c46d289f9dc458a9fccf79add3e49347f2fc984e463"
-	startHeight := 1
-	endHeight := 40
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+	startHeight := height
+	endHeight := height + 10
 
 	coin, err := sdk.ParseCoinNormalized(fmt.Sprintf("1000%s", s.cfg.BondDenom))
 	s.Require().NoError(err)
@@ -101,7 +112,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMerkledrop() {
 	//------test GetCmdCreate()-------------
 	cmd := cli.GetCmdCreate()
 	fileJson, claimInfo, err := accountJson(s.T(), val.Address)
-	outJson := "out.json"
+	outJson := filepath.Join(s.T().TempDir(), "out.json")
 	s.Require().NoError(err)
 
 	args := addCommonFlags(
@@ -123,8 +134,6 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMerkledrop() {
 	txResp := respType.(*sdk.TxResponse)
 	s.Require().Equal(expectedCode, txResp.Code)
 
-	os.Remove(outJson)
-
 	//------test GetCmdQueryMerkledrop()-------------
 	cmd = cli.GetCmdQueryMerkledrop()
 	args = []string{
@@ -155,6 +164,15 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMerkledrop() {
 	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), respType), out.String())
 	txResp = respType.(*sdk.TxResponse)
 	s.Require().Equal(expectedCode, txResp.Code)
+
+	//------test the expiry-------------
+	_, err = s.network.WaitForHeight(endHeight + 1)
+	s.Require().NoError(err)
+
+	// the expired merkledrop is withdrawn and deleted by the end blocker
+	cmd = cli.GetCmdQueryMerkledrop()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{"1"})
+	s.Require().Error(err)
 }
 
 func TestSimpleProof(t *testing.T) {
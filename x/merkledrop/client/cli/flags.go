@@ -11,6 +11,17 @@ const (
 	FlagEndHeight   = "end-height"
 	FlagAmount      = "amount"
 	FlagDenom       = "denom"
+	FlagCoins       = "coins"
+	FlagStartTime   = "start-time"
+	FlagEndTime     = "end-time"
+	FlagStatus      = "status"
+	FlagFeeDenom    = "fee-denom"
+	FlagMultiDenom  = "multi-denom"
+
+	FlagBeneficiary        = "beneficiary"
+	FlagRecipient          = "recipient"
+	FlagRecipientSignature = "recipient-signature"
+	FlagBeneficiaryPubKey  = "beneficiary-pubkey"
 )
 
 func FlagsCreate() *flag.FlagSet {
@@ -18,6 +29,17 @@ func FlagsCreate() *flag.FlagSet {
 	fs.Int64(FlagStartHeight, 0, "Start height of the merkledrop")
 	fs.Int64(FlagEndHeight, 0, "End height of the merkledrop")
 	fs.String(FlagDenom, "", "Denom of the merkledrop")
+	fs.String(FlagStartTime, "", "Start time of the time-based merkledrop, in RFC3339 format")
+	fs.String(FlagEndTime, "", "End time of the time-based merkledrop, in RFC3339 format")
+	fs.String(FlagFeeDenom, "", "Denom of the accepted creation fee to pay, the first accepted fee if empty")
+
+	return fs
+}
+
+func FlagsListMerkledrops() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStatus, "", "Status of the merkledrops (pending, active or expired)")
+	fs.String(FlagDenom, "", "Denom distributed by the merkledrops")
 
 	return fs
 }
@@ -27,6 +49,29 @@ func FlagClaimMerkledrop() *flag.FlagSet {
 
 	fs.String(FlagProofs, "", "Merkle proofs of the merkledrop")
 	fs.Int64(FlagAmount, 0, "Amount of the merkledrop")
+	fs.String(FlagCoins, "", "Coins of the multi denom merkledrop")
+	fs.Uint64(FlagIndex, 0, "Index of the merkledrop")
+	fs.String(FlagBeneficiary, "", "Address of the merkledrop leaf, to claim on its behalf")
+	fs.String(FlagRecipient, "", "Address receiving the claimed coins in place of the beneficiary")
+	fs.String(FlagRecipientSignature, "", "Hex signature of the beneficiary authorizing the recipient")
+	fs.String(FlagBeneficiaryPubKey, "", "Hex secp256k1 public key of the beneficiary")
+
+	return fs
+}
+
+func FlagsTree() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagMultiDenom, false, "Read the coins of every account and build the tree of a multi denom merkledrop")
+
+	return fs
+}
+
+func FlagsVerifyProof() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagProofs, "", "Merkle proofs of the merkledrop")
+	fs.String(FlagAmount, "", "Amount of the merkledrop")
+	fs.String(FlagCoins, "", "Coins of the multi denom merkledrop")
 	fs.Uint64(FlagIndex, 0, "Index of the merkledrop")
 
 	return fs
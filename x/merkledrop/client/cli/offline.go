@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+// TreeInfo is the output of the tree build command
+type TreeInfo struct {
+	MerkleRoot string `json:"merkle_root"`
+	Total      string `json:"total"`
+	Accounts   int    `json:"accounts"`
+}
+
+// GetOfflineCmd returns the merkledrop commands running without a node.
+func GetOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "merkledrop offline subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdTree(),
+	)
+
+	return cmd
+}
+
+// GetCmdTree returns the merkle tree commands.
+func GetCmdTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "tree",
+		Short:                      "Build and verify the merkle trees of the merkledrops",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdTreeBuild(),
+		GetCmdTreeRoot(),
+		GetCmdTreeProof(),
+		GetCmdTreeVerify(),
+	)
+
+	return cmd
+}
+
+func GetCmdTreeBuild() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build [file-json] [out-list-json]",
+		Short: "Build the merkle tree of a merkledrop and write the proofs of the accounts",
+		Long: `Build the merkle tree of a merkledrop, as the create command does, and write the proofs
+of the accounts to the out-list-json, printing the merkle root and the total amount.
+Parameters:
+	file-json: input file list, or the address,amount records of a .csv file
+	out-list-json: output list with proofs
+
+Flags:
+	multi-denom: the file-json contains the coins of every account (e.g. "1000000ubtsg,500ftxyz")
+		`,
+		Example: fmt.Sprintf("$ %s merkledrop tree build accounts.json out-list.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			multiDenom, err := cmd.Flags().GetBool(FlagMultiDenom)
+			if err != nil {
+				return err
+			}
+
+			tree, claimInfo, total, err := buildTreeFromFile(args[0], multiDenom)
+			if err != nil {
+				return err
+			}
+
+			if _, err := createFile(args[1], claimInfo); err != nil {
+				return fmt.Errorf("Could not create file: %v", err)
+			}
+
+			out, err := json.MarshalIndent(TreeInfo{
+				MerkleRoot: fmt.Sprintf("%x", tree.Root()),
+				Total:      total,
+				Accounts:   len(claimInfo),
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagsTree())
+
+	return cmd
+}
+
+func GetCmdTreeRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "root [file-json]",
+		Short:   "Print the merkle root of a merkledrop",
+		Example: fmt.Sprintf("$ %s merkledrop tree root accounts.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			multiDenom, err := cmd.Flags().GetBool(FlagMultiDenom)
+			if err != nil {
+				return err
+			}
+
+			tree, _, _, err := buildTreeFromFile(args[0], multiDenom)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%x\n", tree.Root())
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagsTree())
+
+	return cmd
+}
+
+func GetCmdTreeProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proof [file-json] [address]",
+		Short:   "Print the index, amount and proofs to claim a merkledrop for an address",
+		Example: fmt.Sprintf("$ %s merkledrop tree proof accounts.json bitsong1...", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			multiDenom, err := cmd.Flags().GetBool(FlagMultiDenom)
+			if err != nil {
+				return err
+			}
+
+			_, claimInfo, _, err := buildTreeFromFile(args[0], multiDenom)
+			if err != nil {
+				return err
+			}
+
+			info, ok := claimInfo[args[1]]
+			if !ok {
+				return fmt.Errorf("address %s not found in %s", args[1], args[0])
+			}
+
+			out, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagsTree())
+
+	return cmd
+}
+
+func GetCmdTreeVerify() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [merkle-root] [address]",
+		Short: "Verify the proofs to claim a merkledrop against its merkle root",
+		Long: `Verify the proofs to claim a merkledrop against its merkle root, failing when they are invalid
+Parameters:
+	merkle-root: the hex merkle root of the merkledrop
+	address: the address of the merkledrop leaf
+
+Flags:
+	proofs: merkle-proofs to claim the merkledrop
+	amount: the amount of the merkledrop to claim
+	coins: the coins of the multi denom merkledrop to claim (e.g. 20000ubtsg,100ftxyz)
+	index: the index of the merkledrop to claim
+		`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s merkledrop tree verify 3452cae72dab475d017c1c46d289f9dc458a9fccf79add3e49347f2fc984e463 bitsong1zm6wlhr622yr9d7hh4t70acdfg6c32kcv34duw \
+	--proofs="7a807e653a5d63556f46fd66a2ac9af6bddaa6864611e6b8da2ccf8389a91345,7f0b92cc8318e4fb0db9052325b474e2eabb80d79e6e1abab92093d3a88fe029" \
+	--amount=2000000 \
+	--index=1
+`,
+			version.AppName,
+		)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid merkle root %s: %v", args[0], err)
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			proofsStr, err := cmd.Flags().GetString(FlagProofs)
+			if err != nil {
+				return err
+			}
+			proofs := []string{}
+			if proofsStr != "" {
+				proofs = strings.Split(proofsStr, ",")
+			}
+			for _, proof := range proofs {
+				if _, err := hex.DecodeString(proof); err != nil {
+					return fmt.Errorf("invalid proof %s: %v", proof, err)
+				}
+			}
+
+			index, err := cmd.Flags().GetUint64(FlagIndex)
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			coinsStr, err := cmd.Flags().GetString(FlagCoins)
+			if err != nil {
+				return err
+			}
+
+			var valid bool
+			if coinsStr != "" {
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return err
+				}
+
+				valid = types.IsValidCoinsProof(index, addr, coins, root, types.ConvertProofs(proofs))
+			} else {
+				amount, ok := sdk.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+
+				valid = types.IsValidProof(index, addr, amount, root, types.ConvertProofs(proofs))
+			}
+
+			if !valid {
+				return fmt.Errorf("invalid proofs of %s for the merkle root %s", addr, args[0])
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "valid proofs")
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagsVerifyProof())
+
+	return cmd
+}
+
+// buildTreeFromFile builds the merkle tree of the accounts file, returning the
+// proofs of the accounts and the total amount (or coins) of the merkledrop
+func buildTreeFromFile(filename string, multiDenom bool) (Tree, map[string]ClaimInfo, string, error) {
+	stringList, err := ReadAccountsFile(filename)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if len(stringList) == 0 {
+		return nil, nil, "", fmt.Errorf("no accounts in %s", filename)
+	}
+
+	if multiDenom {
+		accMap, err := AccountsFromCoinsMap(stringList)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("Could not get accounts from map: %v", err)
+		}
+
+		tree, claimInfo, totalCoins, err := CreateCoinsDistributionList(accMap)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("Could not create distribution list: %v", err)
+		}
+
+		return tree, claimInfo, totalCoins.String(), nil
+	}
+
+	accMap, err := AccountsFromMap(stringList)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("Could not get accounts from map: %v", err)
+	}
+
+	tree, claimInfo, totalAmt, err := CreateDistributionList(accMap)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("Could not create distribution list: %v", err)
+	}
+
+	return tree, claimInfo, totalAmt.String(), nil
+}
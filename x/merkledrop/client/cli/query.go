@@ -6,6 +6,7 @@ import (
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"strconv"
@@ -21,7 +22,10 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryMerkledrop(),
+		GetCmdQueryMerkledrops(),
+		GetCmdQueryMerkledropsByOwner(),
 		GetCmdQueryIndexClaimed(),
+		GetCmdQueryClaimedIndexes(),
 		GetCmdQueryParams(),
 	)
 
@@ -63,6 +67,117 @@ func GetCmdQueryMerkledrop() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryMerkledrops() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Query the merkledrops, optionally filtered by status and denom.",
+		Example: fmt.Sprintf(`$ %s query merkledrop list --status=active --denom=ubtsg`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mdStatus, denom, err := parseListFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Merkledrops(context.Background(), &types.QueryMerkledropsRequest{
+				Status:     mdStatus,
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FlagsListMerkledrops())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "merkledrops")
+
+	return cmd
+}
+
+func GetCmdQueryMerkledropsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner [owner]",
+		Short:   "Query the merkledrops of an owner, optionally filtered by status and denom.",
+		Example: fmt.Sprintf(`$ %s query merkledrop owner [owner] --status=pending`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			mdStatus, denom, err := parseListFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MerkledropsByOwner(context.Background(), &types.QueryMerkledropsByOwnerRequest{
+				Owner:      owner.String(),
+				Status:     mdStatus,
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FlagsListMerkledrops())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "merkledrops")
+
+	return cmd
+}
+
+func parseListFlags(cmd *cobra.Command) (types.MerkledropStatus, string, error) {
+	statusStr, err := cmd.Flags().GetString(FlagStatus)
+	if err != nil {
+		return types.StatusUnspecified, "", err
+	}
+
+	mdStatus, err := types.MerkledropStatusFromString(statusStr)
+	if err != nil {
+		return types.StatusUnspecified, "", err
+	}
+
+	denom, err := cmd.Flags().GetString(FlagDenom)
+	if err != nil {
+		return types.StatusUnspecified, "", err
+	}
+
+	return mdStatus, denom, nil
+}
+
 func GetCmdQueryIndexClaimed() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "index-claimed [id] [index]",
@@ -104,6 +219,49 @@ func GetCmdQueryIndexClaimed() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryClaimedIndexes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimed-indexes [id]",
+		Short: "Query the claimed indexes of a merkledrop as a bitmap.",
+		Long: `Query the claimed indexes of a merkledrop as a bitmap, the index bitmap_offset + i
+is claimed if the bit i % 8 of the byte i / 8 of the (base64 encoded) bitmap is set.`,
+		Example: fmt.Sprintf(`$ %s query merkledrop claimed-indexes [id] --limit=10000`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimedIndexes(context.Background(), &types.QueryClaimedIndexesRequest{
+				Id:         id,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claimed indexes")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query fantoken related param command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,158 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitsongofficial/go-bitsong/app/params"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/client/cli"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the testdata")
+
+const testdataDir = "../../testdata"
+
+func execTreeCmd(cmd *cobra.Command, args ...string) (string, error) {
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(new(bytes.Buffer))
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestTreeBuildGolden(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	testCases := []struct {
+		accounts string
+		golden   string
+		root     string
+		flags    []string
+	}{
+		{"accounts.json", "out.json", "3452cae72dab475d017c1c46d289f9dc458a9fccf79add3e49347f2fc984e463", nil},
+		{"accounts.csv", "out.json", "3452cae72dab475d017c1c46d289f9dc458a9fccf79add3e49347f2fc984e463", nil},
+		{"accounts_coins.json", "out_coins.json", "9f73deec0cce6adcfefed270caf507b414770970b554976d46bd1536809be361", []string{"--multi-denom"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.accounts, func(t *testing.T) {
+			outFile := filepath.Join(t.TempDir(), "out.json")
+			args := append([]string{filepath.Join(testdataDir, tc.accounts), outFile}, tc.flags...)
+
+			out, err := execTreeCmd(cli.GetCmdTreeBuild(), args...)
+			require.NoError(t, err)
+
+			var info cli.TreeInfo
+			require.NoError(t, json.Unmarshal([]byte(out), &info))
+			require.Equal(t, tc.root, info.MerkleRoot)
+			require.Equal(t, 3, info.Accounts)
+
+			got, err := os.ReadFile(outFile)
+			require.NoError(t, err)
+
+			goldenFile := filepath.Join(testdataDir, tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(goldenFile, got, 0644))
+			}
+
+			want, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+
+			out, err = execTreeCmd(cli.GetCmdTreeRoot(), append([]string{filepath.Join(testdataDir, tc.accounts)}, tc.flags...)...)
+			require.NoError(t, err)
+			require.Equal(t, tc.root, strings.TrimSpace(out))
+		})
+	}
+}
+
+func TestTreeProofAndVerify(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	accounts := filepath.Join(testdataDir, "accounts.json")
+	root := "3452cae72dab475d017c1c46d289f9dc458a9fccf79add3e49347f2fc984e463"
+
+	bz, err := os.ReadFile(filepath.Join(testdataDir, "out.json"))
+	require.NoError(t, err)
+	var golden map[string]cli.ClaimInfo
+	require.NoError(t, json.Unmarshal(bz, &golden))
+
+	for addr, want := range golden {
+		out, err := execTreeCmd(cli.GetCmdTreeProof(), accounts, addr)
+		require.NoError(t, err)
+
+		var info cli.ClaimInfo
+		require.NoError(t, json.Unmarshal([]byte(out), &info))
+		require.Equal(t, want, info)
+
+		verifyArgs := []string{
+			root, addr,
+			fmt.Sprintf("--proofs=%s", strings.Join(info.Proof, ",")),
+			fmt.Sprintf("--amount=%s", info.Amount),
+			fmt.Sprintf("--index=%d", info.Index),
+		}
+		_, err = execTreeCmd(cli.GetCmdTreeVerify(), verifyArgs...)
+		require.NoError(t, err)
+
+		// a wrong index must not verify
+		verifyArgs[4] = fmt.Sprintf("--index=%d", info.Index+1)
+		_, err = execTreeCmd(cli.GetCmdTreeVerify(), verifyArgs...)
+		require.Error(t, err)
+	}
+
+	_, err = execTreeCmd(cli.GetCmdTreeProof(), accounts, "bitsong10clahhd4g878vzyl69hcnue9uufp5dle4867md")
+	require.Error(t, err)
+
+	// multi denom proofs
+	bz, err = os.ReadFile(filepath.Join(testdataDir, "out_coins.json"))
+	require.NoError(t, err)
+	var goldenCoins map[string]cli.ClaimInfo
+	require.NoError(t, json.Unmarshal(bz, &goldenCoins))
+
+	for addr, info := range goldenCoins {
+		_, err = execTreeCmd(cli.GetCmdTreeVerify(),
+			"9f73deec0cce6adcfefed270caf507b414770970b554976d46bd1536809be361", addr,
+			fmt.Sprintf("--proofs=%s", strings.Join(info.Proof, ",")),
+			fmt.Sprintf("--coins=%s", info.Coins),
+			fmt.Sprintf("--index=%d", info.Index),
+		)
+		require.NoError(t, err)
+	}
+}
+
+func TestCreateDistributionListDeterministic(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	// accounts with the same amount are ordered by address
+	accounts := map[string]string{
+		"bitsong1vgpsha4f8grmsqr6krfdxwpcf3x20h0q3ztaj2": "1000000",
+		"bitsong1zm6wlhr622yr9d7hh4t70acdfg6c32kcv34duw": "1000000",
+		"bitsong1nzxmsks45e55d5edj4mcd08u8dycaxq5eplakw": "1000000",
+	}
+
+	var root string
+	for i := 0; i < 10; i++ {
+		accMap, err := cli.AccountsFromMap(accounts)
+		require.NoError(t, err)
+
+		tree, claimInfo, _, err := cli.CreateDistributionList(accMap)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), claimInfo["bitsong1nzxmsks45e55d5edj4mcd08u8dycaxq5eplakw"].Index)
+
+		if i > 0 {
+			require.Equal(t, root, fmt.Sprintf("%x", tree.Root()))
+		}
+		root = fmt.Sprintf("%x", tree.Root())
+	}
+}
@@ -1,8 +1,9 @@
 package cli
 
 import (
-	"encoding/json"
+	"encoding/hex"
 	"fmt"
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -13,10 +14,10 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
-	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // NewTxCmd returns the transaction commands for the merkledrop module.
@@ -32,6 +33,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreate(),
 		GetCmdClaim(),
+		GetCmdAuthorizeRecipient(),
+		GetCmdWithdraw(),
+		GetCmdFund(),
+		GetCmdExtend(),
+		GetCmdUpdateParams(),
 	)
 
 	return txCmd
@@ -43,13 +49,16 @@ func GetCmdCreate() *cobra.Command {
 		Short: "Create a merkledrop from json file",
 		Long: `Create a merkledrop from json file
 Parameters:
-	file-json: input file list
+	file-json: input file list, or the address,amount records of a .csv file
 	out-list-json: output list with proofs
 
 Flags:
-	denom: the coin denom to distribuite
+	denom: the coin denom to distribuite. When omitted, the file-json must contain the coins
+	       of every account (e.g. "1000000ubtsg,500ftxyz") and a multi denom merkledrop is created
 	start-height: the height when the merkledrop will begin (0 for immediatally)
 	end-height: the height when the merkledrop will ends
+	start-time: the RFC3339 time when a time-based merkledrop will begin (empty for immediatally)
+	end-time: the RFC3339 time when a time-based merkledrop will ends, the heights are ignored when set
 		`,
 		Example: strings.TrimSpace(fmt.Sprintf(`
 $ %s tx merkledrop create accounts.json out-list.json \
@@ -101,39 +110,71 @@ after the computation the out-list.json should be similar to this output
 				return err
 			}
 
-			listBytes, err := ioutil.ReadFile(args[0])
+			stringList, err := ReadAccountsFile(args[0])
 			if err != nil {
 				return err
 			}
 
-			var stringList map[string]string
-			if err := json.Unmarshal(listBytes, &stringList); err != nil {
-				return fmt.Errorf("Could not unmarshal json: %v", err)
-			}
-
-			accMap, err := AccountsFromMap(stringList)
+			startHeight, endHeight, denom, err := parseGenerateFlags(cmd.Flags())
 			if err != nil {
-				return fmt.Errorf("Could not get accounts from map")
+				return err
 			}
 
-			tree, claimInfo, totalAmt, err := CreateDistributionList(accMap)
+			startTime, endTime, err := parseTimeFlags(cmd.Flags())
 			if err != nil {
-				return fmt.Errorf("Could not create distribution list: %v", err)
-			}
-
-			if _, err := createFile(args[1], claimInfo); err != nil {
-				return fmt.Errorf("Could not create file: %v", err)
+				return err
 			}
 
-			startHeight, endHeight, denom, err := parseGenerateFlags(cmd.Flags())
-			merkleRoot := fmt.Sprintf("%x", tree.Root())
-
-			coin, err := sdk.ParseCoinNormalized(fmt.Sprintf("%s%s", totalAmt.String(), denom))
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgCreate(clientCtx.GetFromAddress(), merkleRoot, startHeight, endHeight, coin)
+			var msg *types.MsgCreate
+			if denom != "" {
+				// single denom merkledrop, the list contains the amounts
+				accMap, err := AccountsFromMap(stringList)
+				if err != nil {
+					return fmt.Errorf("Could not get accounts from map")
+				}
+
+				tree, claimInfo, totalAmt, err := CreateDistributionList(accMap)
+				if err != nil {
+					return fmt.Errorf("Could not create distribution list: %v", err)
+				}
+
+				if _, err := createFile(args[1], claimInfo); err != nil {
+					return fmt.Errorf("Could not create file: %v", err)
+				}
+
+				coin, err := sdk.ParseCoinNormalized(fmt.Sprintf("%s%s", totalAmt.String(), denom))
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgCreate(clientCtx.GetFromAddress(), fmt.Sprintf("%x", tree.Root()), startHeight, endHeight, coin)
+			} else {
+				// multi denom merkledrop, the list contains the coins
+				accMap, err := AccountsFromCoinsMap(stringList)
+				if err != nil {
+					return fmt.Errorf("Could not get accounts from map: %v", err)
+				}
+
+				tree, claimInfo, totalCoins, err := CreateCoinsDistributionList(accMap)
+				if err != nil {
+					return fmt.Errorf("Could not create distribution list: %v", err)
+				}
+
+				if _, err := createFile(args[1], claimInfo); err != nil {
+					return fmt.Errorf("Could not create file: %v", err)
+				}
+
+				msg = types.NewMsgCreateWithCoins(clientCtx.GetFromAddress(), fmt.Sprintf("%x", tree.Root()), startHeight, endHeight, totalCoins)
+			}
+
+			msg.StartTime = startTime
+			msg.EndTime = endTime
+			msg.FeeDenom = feeDenom
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -168,6 +209,28 @@ func parseGenerateFlags(flags *flag.FlagSet) (int64, int64, string, error) {
 	return startHeight, endHeight, denom, nil
 }
 
+func parseTimeFlags(flags *flag.FlagSet) (*time.Time, *time.Time, error) {
+	var times [2]*time.Time
+	for i, name := range []string{FlagStartTime, FlagEndTime} {
+		value, err := flags.GetString(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --%s: %w", name, err)
+		}
+		times[i] = &t
+	}
+
+	return times[0], times[1], nil
+}
+
 func GetCmdClaim() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim [id]",
@@ -179,7 +242,12 @@ Parameters:
 Flags:
 	proofs: merkle-proofs to claim the merkledrop
 	amount: the amount of the merkledrop to claim
+	coins: the coins of the multi denom merkledrop to claim (e.g. 20000ubtsg,100ftxyz)
 	index: the index of the merkledrop to claim
+	beneficiary: the address of the merkledrop leaf, when claiming on its behalf
+	recipient: the address receiving the coins, authorized by the beneficiary
+	recipient-signature: the signature of the beneficiary from the authorize-recipient command
+	beneficiary-pubkey: the public key of the beneficiary from the authorize-recipient command
 		`,
 		Example: strings.TrimSpace(fmt.Sprintf(`
 $ %s tx merkledrop claim 1 \
@@ -216,12 +284,49 @@ $ %s tx merkledrop claim 1 \
 				return err
 			}
 
+			coinsStr, err := cmd.Flags().GetString(FlagCoins)
+			if err != nil {
+				return err
+			}
+
 			index, err := cmd.Flags().GetUint64(FlagIndex)
 			if err != nil {
 				return err
 			}
 
 			msg := types.NewMsgClaim(index, merkledropId, sdk.NewInt(amount), proofs, clientCtx.GetFromAddress())
+			if coinsStr != "" {
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgClaimWithCoins(index, merkledropId, coins, proofs, clientCtx.GetFromAddress())
+			}
+
+			if msg.Beneficiary, err = cmd.Flags().GetString(FlagBeneficiary); err != nil {
+				return err
+			}
+
+			if msg.Recipient, err = cmd.Flags().GetString(FlagRecipient); err != nil {
+				return err
+			}
+
+			signatureStr, err := cmd.Flags().GetString(FlagRecipientSignature)
+			if err != nil {
+				return err
+			}
+			if msg.RecipientSignature, err = hex.DecodeString(signatureStr); err != nil {
+				return err
+			}
+
+			pubKeyStr, err := cmd.Flags().GetString(FlagBeneficiaryPubKey)
+			if err != nil {
+				return err
+			}
+			if msg.BeneficiaryPubKey, err = hex.DecodeString(pubKeyStr); err != nil {
+				return err
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -237,20 +342,272 @@ $ %s tx merkledrop claim 1 \
 	return cmd
 }
 
+func GetCmdAuthorizeRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize-recipient [id] [index] [recipient]",
+		Short: "Sign the authorization for a recipient to receive the coins of a merkledrop leaf",
+		Long: `Sign, with the key of the beneficiary of a merkledrop leaf, the authorization for
+a recipient to receive the claimed coins. The printed signature and public key are
+used by the claim command with the --recipient-signature and --beneficiary-pubkey flags.
+Only secp256k1 keys are supported.`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s tx merkledrop authorize-recipient 1 10 bitsong1zm6wlhr622yr9d7hh4t70acdfg6c32kcv34duw \
+	--from=<beneficiary-key-name> --chain-id=<chain-id>
+`,
+			version.AppName,
+		)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merkledropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			signBytes := types.RecipientAuthorizationSignBytes(clientCtx.ChainID, merkledropId, index, recipient)
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s: %X\n%s: %X\n", FlagRecipientSignature, signature, FlagBeneficiaryPubKey, pubKey.Bytes()))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [id]",
+		Short: "Withdraw the unclaimed coins of a merkledrop",
+		Long: `Withdraw the unclaimed coins of a merkledrop, allowed to the owner before
+the start height or once the withdraw grace period is elapsed
+Parameters:
+	id: merkledrop id
+		`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s tx merkledrop withdraw 1 \
+	--from=<key-name>
+`,
+			version.AppName,
+		)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merkledropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), merkledropId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund [id] [coins]",
+		Short: "Deposit more coins into a merkledrop",
+		Long: `Deposit more coins into a merkledrop, the denoms must be already distributed by the merkledrop
+Parameters:
+	id: merkledrop id
+	coins: the coins to deposit
+		`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s tx merkledrop fund 1 1000000ubtsg \
+	--from=<key-name>
+`,
+			version.AppName,
+		)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merkledropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFund(clientCtx.GetFromAddress(), merkledropId, coins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdExtend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend [id] [end-height|end-time]",
+		Short: "Push out the end height or the end time of a merkledrop",
+		Long: `Push out the end height of a merkledrop, up to the start height + 5000000,
+or the end time of a time-based merkledrop, up to the start time + 365 days
+Parameters:
+	id: merkledrop id
+	end-height|end-time: the new end height, or the new RFC3339 end time
+		`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s tx merkledrop extend 1 200000 \
+	--from=<key-name>
+$ %s tx merkledrop extend 2 2023-06-01T00:00:00Z \
+	--from=<key-name>
+`,
+			version.AppName,
+			version.AppName,
+		)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merkledropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgExtend
+			if endHeight, err := strconv.ParseInt(args[1], 10, 64); err == nil {
+				msg = types.NewMsgExtend(clientCtx.GetFromAddress(), merkledropId, endHeight)
+			} else {
+				endTime, err := time.Parse(time.RFC3339, args[1])
+				if err != nil {
+					return fmt.Errorf("invalid end height or end time %s", args[1])
+				}
+
+				msg = types.NewMsgExtendTime(clientCtx.GetFromAddress(), merkledropId, endTime)
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Short: "Replace all the merkledrop params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace all the merkledrop params at once. The transaction must be signed by
+the params authority, the gov module account by default.
+The new params must be supplied via a JSON file.
+Example:
+$ %s tx merkledrop update-params <path/to/params.json> --from=<authority>
+Where params.json contains:
+{
+  "creation_fee": [{"denom": "ubtsg", "amount": "1000000"}],
+  "withdraw_grace_period": "100000",
+  "withdraw_grace_duration": "604800s"
+}
+`, version.AppName,
+			),
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx merkledrop update-params [params-file] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(params, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdUpdateMerkledropFees() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-merkledrop-fees [proposal-file]",
 		Short: "Submit an update merkledrop fees proposal.",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit an update merkledrop fees proposal along with an initial deposit.
-The proposal details must be supplied via a JSON file.
+The proposal details must be supplied via a JSON file. The creation fee is a
+comma separated list of the accepted coins, the first one being charged when
+the owner does not choose a fee denom; an empty fee makes the creation free.
 Example:
 $ %s tx gov submit-proposal update-merkledrop-fees <path/to/proposal.json> --from=<key_or_address>
 Where proposal.json contains:
 {
   "title": "Update Merkledrop Fees Proposal",
   "description": "update the current fees",
-  "creation_fee": "1000000ubtsg",
+  "creation_fee": "1000000ubtsg,1000000ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
   "deposit": "500000000ubtsg"
 }
 `, version.AppName,
@@ -275,7 +632,7 @@ Where proposal.json contains:
 				return err
 			}
 
-			creationFee, err := sdk.ParseCoinNormalized(proposal.CreationFee)
+			creationFee, err := feestypes.ParseAcceptedFees(proposal.CreationFee)
 			if err != nil {
 				return err
 			}
@@ -317,3 +674,76 @@ func parseUpdateFeesProposal(cdc codec.JSONCodec, proposalFile string) (types.Up
 
 	return proposal, nil
 }
+
+// GetCmdUpdateMerkledropParams implements a command handler for submitting an
+// update merkledrop params proposal transaction.
+func GetCmdUpdateMerkledropParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-merkledrop-params [proposal-file]",
+		Short: "Submit an update merkledrop params proposal.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal replacing all the merkledrop params, along with an initial deposit.
+The proposal is executed as a MsgUpdateParams signed by the params authority.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal update-merkledrop-params <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Update Merkledrop Params Proposal",
+  "description": "update the current params",
+  "params": {
+    "creation_fee": [{"denom": "ubtsg", "amount": "1000000"}],
+    "withdraw_grace_period": "100800",
+    "withdraw_grace_duration": "604800s"
+  },
+  "deposit": "500000000ubtsg"
+}
+`, version.AppName,
+			),
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal update-merkledrop-params [proposal-file] "+
+				"--from=<key-name> "+
+				"--chain-id=<chain-id> "+
+				"--fees=<fee>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal := types.UpdateParamsProposalWithDeposit{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateParamsProposal(proposal.Title, proposal.Description, proposal.Params)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -1,26 +1,73 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 	"os"
+	"path/filepath"
 	"sort"
-	"strconv"
+	"strings"
 )
 
 type Account struct {
 	address sdk.AccAddress
 	amount  sdk.Int
+	coins   sdk.Coins
 }
 
 type ClaimInfo struct {
 	Index  uint64   `json:"index"`
-	Amount string   `json:"amount"`
+	Amount string   `json:"amount,omitempty"`
+	Coins  string   `json:"coins,omitempty"`
 	Proof  []string `json:"proof"`
 }
 
+// ReadAccountsFile reads the map of address => amount (or coins) of a merkledrop
+// from a JSON object or, when the file has the .csv extension, from the address,amount
+// records of a CSV file, whose first record can be an address,amount header
+func ReadAccountsFile(filename string) (map[string]string, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(filepath.Ext(filename), ".csv") {
+		var accMap map[string]string
+		if err := json.Unmarshal(bz, &accMap); err != nil {
+			return nil, fmt.Errorf("Could not unmarshal json: %v", err)
+		}
+		return accMap, nil
+	}
+
+	reader := csv.NewReader(strings.NewReader(string(bz)))
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("Could not read csv: %v", err)
+	}
+
+	if len(records) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), "address") {
+		records = records[1:]
+	}
+
+	accMap := make(map[string]string, len(records))
+	for _, record := range records {
+		addr := strings.TrimSpace(record[0])
+		if _, ok := accMap[addr]; ok {
+			return nil, fmt.Errorf("duplicate address %s", addr)
+		}
+		accMap[addr] = strings.TrimSpace(record[1])
+	}
+
+	return accMap, nil
+}
+
 func AccountsFromMap(accMap map[string]string) ([]*Account, error) {
 	i := 0
 	accsMap := make([]*Account, len(accMap))
@@ -46,18 +93,50 @@ func AccountsFromMap(accMap map[string]string) ([]*Account, error) {
 	return accsMap, nil
 }
 
+// AccountsFromCoinsMap parses a map of address => coins (e.g. 1000ubtsg,200ftxyz)
+func AccountsFromCoinsMap(accMap map[string]string) ([]*Account, error) {
+	i := 0
+	accsMap := make([]*Account, len(accMap))
+
+	for strAddr, strCoins := range accMap {
+		coins, err := sdk.ParseCoinsNormalized(strCoins)
+		if err != nil {
+			return nil, fmt.Errorf("could not cast %s to sdk.Coins: %v", strCoins, err)
+		}
+
+		if coins.Empty() {
+			return nil, fmt.Errorf("empty coins for %s", strAddr)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(strAddr)
+		if err != nil {
+			return nil, fmt.Errorf("could not cast %s to sdk.AccAddress", strAddr)
+		}
+
+		accsMap[i] = &Account{
+			address: addr,
+			coins:   coins,
+		}
+		i++
+	}
+
+	return accsMap, nil
+}
+
 func CreateDistributionList(accounts []*Account) (Tree, map[string]ClaimInfo, sdk.Int, error) {
-	// sort lists by coin amount
+	// sort lists by coin amount, then by address, so that the tree is deterministic
 	sort.Slice(accounts, func(i, j int) bool {
-		return accounts[i].amount.LT(accounts[j].amount)
+		if !accounts[i].amount.Equal(accounts[j].amount) {
+			return accounts[i].amount.LT(accounts[j].amount)
+		}
+		return accounts[i].address.String() < accounts[j].address.String()
 	})
 
 	totalAmt := sdk.ZeroInt()
 
 	nodes := make([][]byte, len(accounts))
 	for i, acc := range accounts {
-		indexStr := strconv.FormatUint(uint64(i), 10)
-		nodes[i] = []byte(fmt.Sprintf("%s%s%s", indexStr, acc.address.String(), acc.amount.String()))
+		nodes[i] = types.LeafV1(uint64(i), acc.address, acc.amount)
 		totalAmt = totalAmt.Add(acc.amount)
 	}
 
@@ -78,6 +157,39 @@ func CreateDistributionList(accounts []*Account) (Tree, map[string]ClaimInfo, sd
 	return tree, addrToProof, totalAmt, nil
 }
 
+// CreateCoinsDistributionList creates the merkle tree of a multi denom merkledrop,
+// whose leaves are encoded with the leaf version 2
+func CreateCoinsDistributionList(accounts []*Account) (Tree, map[string]ClaimInfo, sdk.Coins, error) {
+	// sort lists by address, so that the tree is deterministic
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].address.String() < accounts[j].address.String()
+	})
+
+	totalCoins := sdk.NewCoins()
+
+	nodes := make([][]byte, len(accounts))
+	for i, acc := range accounts {
+		nodes[i] = types.LeafV2(uint64(i), acc.address, acc.coins)
+		totalCoins = totalCoins.Add(acc.coins...)
+	}
+
+	tree := NewTree(nodes...)
+
+	addrToProof := make(map[string]ClaimInfo, len(accounts))
+
+	for i, acc := range accounts {
+		proof := ProofBytesToString(tree.Proof(crypto.Sha256(nodes[i])))
+
+		addrToProof[acc.address.String()] = ClaimInfo{
+			Index: uint64(i),
+			Coins: acc.coins.String(),
+			Proof: proof,
+		}
+	}
+
+	return tree, addrToProof, totalCoins, nil
+}
+
 func ProofBytesToString(proof [][]byte) []string {
 	str := make([]string, len(proof)-1)
 	for i, p := range proof {
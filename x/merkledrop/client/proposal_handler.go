@@ -7,7 +7,10 @@ import (
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
-var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdUpdateMerkledropFees, ProposalRESTHandler)
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdUpdateMerkledropFees, ProposalRESTHandler)
+	ParamsProposalHandler = govclient.NewProposalHandler(cli.GetCmdUpdateMerkledropParams, ProposalRESTHandler)
+)
 
 func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{}
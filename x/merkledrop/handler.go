@@ -1,6 +1,7 @@
 package merkledrop
 
 import (
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,22 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Claim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgWithdraw:
+			res, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFund:
+			res, err := msgServer.Fund(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgExtend:
+			res, err := msgServer.Extend(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized merkledrop message type: %T", msg)
 		}
@@ -36,6 +53,9 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.UpdateFeesProposal:
 			return handleUpdateFeesProposal(ctx, k, c)
 
+		case *types.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized merkledrop proposal content type: %T", c)
 		}
@@ -45,7 +65,7 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 func handleUpdateFeesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateFeesProposal) error {
 	ctx.Logger().Info("Updating fantoken fees from proposal")
 
-	if err := p.CreationFee.Validate(); err != nil {
+	if err := feestypes.ValidateAcceptedFees(p.CreationFee); err != nil {
 		return err
 	}
 
@@ -55,3 +75,13 @@ func handleUpdateFeesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateF
 
 	return nil
 }
+
+// handleUpdateParamsProposal executes the MsgUpdateParams of the proposal with
+// the params authority, so that the gov module can replace the params
+func handleUpdateParamsProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateParamsProposal) error {
+	ctx.Logger().Info("Updating merkledrop params from proposal")
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(p.Params, k.GetAuthority()))
+	return err
+}
@@ -0,0 +1,35 @@
+package merkledrop_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/bitsongofficial/go-bitsong/app"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+)
+
+func TestUpdateParamsProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the proposal is routed by the gov module of the app
+	h := app.GovKeeper.Router().GetRoute(types.RouterKey)
+
+	newParams := types.NewParams([]sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))}, 10, time.Hour)
+	proposal := types.NewUpdateParamsProposal("Test", "description", newParams)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, h(ctx, proposal))
+	require.Equal(t, newParams, app.MerkledropKeeper.GetParamSet(ctx))
+
+	// the invalid params are rejected and the current ones are kept
+	invalidParams := newParams
+	invalidParams.WithdrawGracePeriod = -1
+	proposal = types.NewUpdateParamsProposal("Test", "description", invalidParams)
+	require.Error(t, proposal.ValidateBasic())
+	require.Error(t, h(ctx, proposal))
+	require.Equal(t, newParams, app.MerkledropKeeper.GetParamSet(ctx))
+}
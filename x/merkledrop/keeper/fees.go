@@ -1,16 +1,23 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
-// DeductCreationFee performs fee handling for merkledrop creation
-func (k Keeper) DeductCreationFee(ctx sdk.Context, owner sdk.AccAddress) error {
-	params := k.GetParamSet(ctx)
+// DeductCreationFee performs fee handling for merkledrop creation, in the accepted fee of the given denom
+func (k Keeper) DeductCreationFee(ctx sdk.Context, owner sdk.AccAddress, feeDenom string) error {
+	fee, err := feestypes.SelectFee(k.GetParamSet(ctx).CreationFee, feeDenom)
+	if err != nil {
+		return err
+	}
 
 	// check if amount is zero
-	if params.CreationFee.Amount.IsZero() || params.CreationFee.Amount.IsNegative() {
+	if fee.IsZero() {
 		return nil
 	}
 
-	// send issue fantoken fee to community pool
-	return k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{params.CreationFee}, owner)
+	// distribute the creation fee according to the fee split
+	return k.feesKeeper.DistributeFees(ctx, types.ModuleName, types.TypeMsgCreate, owner, fee)
 }
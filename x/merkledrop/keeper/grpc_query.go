@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -47,3 +49,125 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (k Keeper) Merkledrops(c context.Context, req *types.QueryMerkledropsRequest) (*types.QueryMerkledropsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixMerkleDrop)
+
+	var merkledrops []types.Merkledrop
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var merkledrop types.Merkledrop
+		if err := k.cdc.Unmarshal(value, &merkledrop); err != nil {
+			return false, err
+		}
+
+		if !k.matchMerkledrop(ctx, merkledrop, req.Status, req.Denom) {
+			return false, nil
+		}
+
+		if accumulate {
+			merkledrops = append(merkledrops, merkledrop)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryMerkledropsResponse{
+		Merkledrops: merkledrops,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) MerkledropsByOwner(c context.Context, req *types.QueryMerkledropsByOwnerRequest) (*types.QueryMerkledropsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address (%s)", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkledropOwnerPrefix(owner))
+
+	var merkledrops []types.Merkledrop
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		merkledrop, err := k.getMerkleDropById(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return false, err
+		}
+
+		if merkledrop.Owner != req.Owner || !k.matchMerkledrop(ctx, merkledrop, req.Status, req.Denom) {
+			return false, nil
+		}
+
+		if accumulate {
+			merkledrops = append(merkledrops, merkledrop)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryMerkledropsByOwnerResponse{
+		Merkledrops: merkledrops,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// matchMerkledrop returns true if the merkledrop has the given status and distributes the given denom,
+// an unspecified status and an empty denom match any merkledrop
+func (k Keeper) matchMerkledrop(ctx sdk.Context, merkledrop types.Merkledrop, mdStatus types.MerkledropStatus, denom string) bool {
+	if mdStatus != types.StatusUnspecified && merkledrop.GetStatus(ctx.BlockHeight(), ctx.BlockTime()) != mdStatus {
+		return false
+	}
+
+	if denom != "" && !merkledrop.Coins.AmountOf(denom).IsPositive() {
+		return false
+	}
+
+	return true
+}
+
+func (k Keeper) ClaimedIndexes(c context.Context, req *types.QueryClaimedIndexesRequest) (*types.QueryClaimedIndexesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimedBitmapKey(req.Id))
+
+	var offset uint64
+	var indexes []uint64
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		word := sdk.BigEndianToUint64(key)
+		if len(indexes) == 0 {
+			offset = word * types.ClaimedBitmapWordSize
+		}
+
+		bits := sdk.BigEndianToUint64(value)
+		for bit := uint64(0); bit < types.ClaimedBitmapWordSize; bit++ {
+			if bits&(1<<bit) != 0 {
+				indexes = append(indexes, word*types.ClaimedBitmapWordSize+bit)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryClaimedIndexesResponse{
+		BitmapOffset: offset,
+		Bitmap:       types.NewBitmap(offset, indexes),
+		Pagination:   pageRes,
+	}, nil
+}
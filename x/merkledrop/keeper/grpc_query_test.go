@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQuery_Merkledrops() {
+	suite.SetupTest()
+	mk := suite.App.MerkledropKeeper
+	ctx := suite.Ctx.WithBlockHeight(15)
+	owner1, owner2 := suite.TestAccs[0], suite.TestAccs[1]
+
+	newMerkledrop := func(id uint64, owner sdk.AccAddress, startHeight, endHeight int64, coins sdk.Coins) types.Merkledrop {
+		return types.Merkledrop{
+			Id:           id,
+			MerkleRoot:   "sdsd",
+			StartHeight:  startHeight,
+			EndHeight:    endHeight,
+			Amount:       sdk.ZeroInt(),
+			Claimed:      sdk.ZeroInt(),
+			Owner:        owner.String(),
+			Coins:        coins,
+			ClaimedCoins: sdk.NewCoins(),
+			LeafVersion:  types.LeafVersion2,
+		}
+	}
+
+	ubtsg := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100))
+	ftfoo := sdk.NewCoins(sdk.NewInt64Coin("ftfoo", 100))
+	merkledrops := []types.Merkledrop{
+		newMerkledrop(1, owner1, 10, 20, ubtsg),               // active
+		newMerkledrop(2, owner1, 16, 30, ftfoo),               // pending
+		newMerkledrop(3, owner2, 1, 15, ubtsg),                // expired
+		newMerkledrop(4, owner2, 5, 100, ubtsg.Add(ftfoo...)), // active
+	}
+	for _, md := range merkledrops {
+		suite.Require().NoError(mk.SetMerkleDrop(ctx, md))
+	}
+
+	ids := func(mds []types.Merkledrop) []uint64 {
+		var res []uint64
+		for _, md := range mds {
+			res = append(res, md.Id)
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		status types.MerkledropStatus
+		denom  string
+		ids    []uint64
+	}{
+		{"all", types.StatusUnspecified, "", []uint64{1, 2, 3, 4}},
+		{"pending", types.StatusPending, "", []uint64{2}},
+		{"active", types.StatusActive, "", []uint64{1, 4}},
+		{"expired", types.StatusExpired, "", []uint64{3}},
+		{"denom", types.StatusUnspecified, "ftfoo", []uint64{2, 4}},
+		{"active denom", types.StatusActive, "ftfoo", []uint64{4}},
+		{"unknown denom", types.StatusUnspecified, "ftbar", nil},
+	} {
+		res, err := mk.Merkledrops(sdk.WrapSDKContext(ctx), &types.QueryMerkledropsRequest{Status: tc.status, Denom: tc.denom})
+		suite.Require().NoError(err, tc.desc)
+		suite.Require().Equal(tc.ids, ids(res.Merkledrops), tc.desc)
+	}
+
+	// pagination
+	res, err := mk.Merkledrops(sdk.WrapSDKContext(ctx), &types.QueryMerkledropsRequest{
+		Status:     types.StatusActive,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1}, ids(res.Merkledrops))
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = mk.Merkledrops(sdk.WrapSDKContext(ctx), &types.QueryMerkledropsRequest{
+		Status:     types.StatusActive,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{4}, ids(res.Merkledrops))
+
+	// by owner
+	for _, tc := range []struct {
+		desc   string
+		owner  sdk.AccAddress
+		status types.MerkledropStatus
+		denom  string
+		ids    []uint64
+	}{
+		{"owner1", owner1, types.StatusUnspecified, "", []uint64{1, 2}},
+		{"owner1 pending", owner1, types.StatusPending, "", []uint64{2}},
+		{"owner2 ubtsg", owner2, types.StatusUnspecified, "ubtsg", []uint64{3, 4}},
+		{"owner2 active ftfoo", owner2, types.StatusActive, "ftfoo", []uint64{4}},
+		{"no merkledrops", suite.TestAccs[2], types.StatusUnspecified, "", nil},
+	} {
+		res, err := mk.MerkledropsByOwner(sdk.WrapSDKContext(ctx), &types.QueryMerkledropsByOwnerRequest{
+			Owner:  tc.owner.String(),
+			Status: tc.status,
+			Denom:  tc.denom,
+		})
+		suite.Require().NoError(err, tc.desc)
+		suite.Require().Equal(tc.ids, ids(res.Merkledrops), tc.desc)
+	}
+
+	_, err = mk.MerkledropsByOwner(sdk.WrapSDKContext(ctx), &types.QueryMerkledropsByOwnerRequest{Owner: "invalid"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuery_ClaimedIndexes() {
+	suite.SetupTest()
+	mk := suite.App.MerkledropKeeper
+	ctx := suite.Ctx
+
+	claimed := []uint64{3, 4, 10, 17, 300}
+	for _, index := range claimed {
+		mk.SetClaimed(ctx, 1, index)
+	}
+	mk.SetClaimed(ctx, 2, 5)
+
+	decode := func(res *types.QueryClaimedIndexesResponse) []uint64 {
+		var indexes []uint64
+		for i := uint64(0); i < uint64(len(res.Bitmap))*8; i++ {
+			if types.IsBitSet(res.Bitmap, i) {
+				indexes = append(indexes, res.BitmapOffset+i)
+			}
+		}
+		return indexes
+	}
+
+	res, err := mk.ClaimedIndexes(sdk.WrapSDKContext(ctx), &types.QueryClaimedIndexesRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.BitmapOffset)
+	suite.Require().Len(res.Bitmap, 38)
+	suite.Require().Equal(claimed, decode(res))
+
+	// pagination over the bitmap words
+	res, err = mk.ClaimedIndexes(sdk.WrapSDKContext(ctx), &types.QueryClaimedIndexesRequest{
+		Id:         1,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 4, 10, 17}, decode(res))
+
+	res, err = mk.ClaimedIndexes(sdk.WrapSDKContext(ctx), &types.QueryClaimedIndexesRequest{
+		Id:         1,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(256), res.BitmapOffset)
+	suite.Require().Equal([]uint64{300}, decode(res))
+
+	// no claimed indexes
+	res, err = mk.ClaimedIndexes(sdk.WrapSDKContext(ctx), &types.QueryClaimedIndexesRequest{Id: 3})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Bitmap)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the merkledrop module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claimed-coins", ClaimedCoinsInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the merkledrop module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ClaimedCoinsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account balance equals the unclaimed coins of all the merkledrops
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, merkledrop := range k.GetAllMerkleDrops(ctx) {
+			// the merkledrops with claimed coins > coins are reported by the claimed-coins invariant
+			if !merkledrop.Coins.IsAllGTE(merkledrop.ClaimedCoins) {
+				continue
+			}
+			expected = expected.Add(merkledrop.GetUnclaimedCoins()...)
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		// IsEqual panics on the coins of different denoms, compare both ways instead
+		broken := !balance.IsAllGTE(expected) || !expected.IsAllGTE(balance)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tmodule account balance: %s\n\tunclaimed merkledrop coins: %s\n", balance, expected),
+		), broken
+	}
+}
+
+// ClaimedCoinsInvariant checks that the claimed coins of every merkledrop do not exceed its coins
+func ClaimedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, merkledrop := range k.GetAllMerkleDrops(ctx) {
+			if !merkledrop.Coins.IsAllGTE(merkledrop.ClaimedCoins) {
+				broken++
+				msg += fmt.Sprintf("\tmerkledrop %d claimed coins %s exceed the coins %s\n", merkledrop.Id, merkledrop.ClaimedCoins, merkledrop.Coins)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "claimed-coins",
+			fmt.Sprintf("%d merkledrops with claimed coins exceeding the coins found\n%s", broken, msg),
+		), broken != 0
+	}
+}
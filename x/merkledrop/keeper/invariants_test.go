@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/client/cli"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+	mk := suite.App.MerkledropKeeper
+	msgSrv := keeper.NewMsgServerImpl(mk)
+	owner := suite.TestAccs[0]
+
+	accMap, err := cli.AccountsFromCoinsMap(map[string]string{
+		suite.TestAccs[1].String(): "1000ubtsg,10ftfoo",
+		suite.TestAccs[2].String(): "2000ubtsg",
+	})
+	suite.Require().NoError(err)
+	tree, claimInfo, totalCoins, err := cli.CreateCoinsDistributionList(accMap)
+	suite.Require().NoError(err)
+
+	creationFee := mk.GetParamSet(suite.Ctx).CreationFee[0]
+	suite.fundAccount(owner, totalCoins.Add(creationFee))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, fmt.Sprintf("%x", tree.Root()), suite.Ctx.BlockHeight(), suite.Ctx.BlockHeight()+100, totalCoins,
+	))
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(mk)(suite.Ctx)
+	suite.Require().False(broken)
+
+	info := claimInfo[suite.TestAccs[1].String()]
+	coins, err := sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, coins, info.Proof, suite.TestAccs[1],
+	))
+	suite.Require().NoError(err)
+
+	_, broken = keeper.AllInvariants(mk)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// claimed coins exceeding the coins
+	merkledrop, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	merkledrop.ClaimedCoins = merkledrop.Coins.Add(sdk.NewInt64Coin("ubtsg", 1))
+	suite.Require().NoError(mk.SetMerkleDrop(suite.Ctx, merkledrop))
+
+	_, broken = keeper.ClaimedCoinsInvariant(mk)(suite.Ctx)
+	suite.Require().True(broken)
+
+	// the module balance does not match the unclaimed coins
+	merkledrop.ClaimedCoins = coins.Add(sdk.NewInt64Coin("ubtsg", 1))
+	suite.Require().NoError(mk.SetMerkleDrop(suite.Ctx, merkledrop))
+
+	_, broken = keeper.ClaimedCoinsInvariant(mk)(suite.Ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.ModuleBalanceInvariant(mk)(suite.Ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestModuleBalanceInvariantDenoms() {
+	suite.SetupTest()
+	mk := suite.App.MerkledropKeeper
+	msgSrv := keeper.NewMsgServerImpl(mk)
+	owner := suite.TestAccs[0]
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000))
+	creationFee := mk.GetParamSet(suite.Ctx).CreationFee[0]
+	suite.fundAccount(owner, coins.Add(creationFee))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, "", suite.Ctx.BlockHeight(), suite.Ctx.BlockHeight()+100, coins,
+	))
+	suite.Require().NoError(err)
+
+	// the module balance and the unclaimed coins have the same length but
+	// different denoms
+	merkledrop, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	merkledrop.Coins = sdk.NewCoins(sdk.NewInt64Coin("ftfoo", 1000))
+	suite.Require().NoError(mk.SetMerkleDrop(suite.Ctx, merkledrop))
+
+	var broken bool
+	suite.Require().NotPanics(func() {
+		_, broken = keeper.ModuleBalanceInvariant(mk)(suite.Ctx)
+	})
+	suite.Require().True(broken)
+}
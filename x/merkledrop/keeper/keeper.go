@@ -15,9 +15,16 @@ type Keeper struct {
 	cdc           codec.Codec
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
-	distrKeeper   types.DistrKeeper
+	feesKeeper    types.FeesKeeper
+
+	// the bank keeper refunding the unclaimed coins to the owners, it does not
+	// enforce the fantoken pause so that a paused denom cannot lock a refund
+	refundKeeper types.BankKeeper
 
 	paramSpace types.ParamSubspace
+
+	// the address allowed to update the params, usually the gov module account
+	authority string
 }
 
 func NewKeeper(
@@ -25,8 +32,10 @@ func NewKeeper(
 	key sdk.StoreKey,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
-	dk types.DistrKeeper,
+	rk types.BankKeeper,
+	fk types.FeesKeeper,
 	paramSpace paramstypes.Subspace,
+	authority string,
 ) Keeper {
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the " + types.ModuleName + " module account has not been set")
@@ -42,11 +51,18 @@ func NewKeeper(
 		cdc:           cdc,
 		accountKeeper: ak,
 		bankKeeper:    bk,
-		distrKeeper:   dk,
+		feesKeeper:    fk,
+		refundKeeper:  rk,
 		paramSpace:    paramSpace,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the merkledrop params
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("go-bitsong/%s", types.ModuleName))
@@ -59,30 +75,31 @@ func (k Keeper) Withdraw(ctx sdk.Context, merkledropID uint64) error {
 		return sdkerrors.Wrapf(types.ErrMerkledropNotExist, "merkledrop: %d does not exist", merkledropID)
 	}
 
-	// check if total amount < claimed amount  (who knows?)
-	if merkledrop.Amount.LT(merkledrop.Claimed) {
-		panic(fmt.Errorf("merkledrop-id: %d, total_amount (%s) < claimed_amount (%s)", merkledrop.Id, merkledrop.Amount, merkledrop.Claimed))
+	// check if total coins < claimed coins  (who knows?)
+	if !merkledrop.Coins.IsAllGTE(merkledrop.ClaimedCoins) {
+		panic(fmt.Errorf("merkledrop-id: %d, total_coins (%s) < claimed_coins (%s)", merkledrop.Id, merkledrop.Coins, merkledrop.ClaimedCoins))
 	}
 
 	// get balance
-	balance := merkledrop.Amount.Sub(merkledrop.Claimed)
+	balance := merkledrop.GetUnclaimedCoins()
 
-	// send coins
-	coin := sdk.NewCoin(merkledrop.Denom, balance)
 	owner, err := sdk.AccAddressFromBech32(merkledrop.Owner)
 	if err != nil {
 		return err
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.Coins{coin})
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrTransferCoins, "%s", coin)
+	// send coins
+	if !balance.Empty() {
+		err = k.refundKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, balance)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrTransferCoins, "%s", balance)
+		}
 	}
 
 	// emit event
 	ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
 		MerkledropId: merkledrop.Id,
-		Coin:         coin,
+		Coins:        balance,
 	})
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"time"
 )
 
 /*func (k Keeper) GetModuleAccountAddress(ctx sdk.Context) sdk.AccAddress {
@@ -43,20 +44,58 @@ func (k Keeper) SetMerkleDrop(ctx sdk.Context, merkledrop types.Merkledrop) erro
 	// set key by owner
 	store.Set(types.MerkledropOwnerKey(merkledrop.Id, owner), sdk.Uint64ToBigEndian(merkledrop.Id))
 
-	// set key by end-height
-	store.Set(types.MerkledropEndHeightAndIDKey(merkledrop.EndHeight, merkledrop.Id), []byte{0x01})
+	// set key by end-time or end-height
+	if merkledrop.IsTimeBased() {
+		store.Set(types.MerkledropEndTimeAndIDKey(*merkledrop.EndTime, merkledrop.Id), []byte{0x01})
+	} else {
+		store.Set(types.MerkledropEndHeightAndIDKey(merkledrop.EndHeight, merkledrop.Id), []byte{0x01})
+	}
 
 	return nil
 }
 
-func (k Keeper) IsClaimed(ctx sdk.Context, mdId, index uint64) bool {
+// SetMerkleDropEndHeight updates the end height of the merkledrop, re-keying the end-height index
+func (k Keeper) SetMerkleDropEndHeight(ctx sdk.Context, merkledrop types.Merkledrop, endHeight int64) error {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.ClaimedMerkledropIndexKey(mdId, index))
+	store.Delete(types.MerkledropEndHeightAndIDKey(merkledrop.EndHeight, merkledrop.Id))
+
+	merkledrop.EndHeight = endHeight
+	return k.SetMerkleDrop(ctx, merkledrop)
+}
+
+// SetMerkleDropEndTime updates the end time of a time-based merkledrop, re-keying the end-time index
+func (k Keeper) SetMerkleDropEndTime(ctx sdk.Context, merkledrop types.Merkledrop, endTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	if merkledrop.EndTime != nil {
+		store.Delete(types.MerkledropEndTimeAndIDKey(*merkledrop.EndTime, merkledrop.Id))
+	}
+
+	merkledrop.EndTime = &endTime
+	return k.SetMerkleDrop(ctx, merkledrop)
+}
+
+func (k Keeper) IsClaimed(ctx sdk.Context, mdId, index uint64) bool {
+	word, bit := types.ClaimedBitmapWordPosition(index)
+	return k.getClaimedWord(ctx, mdId, word)&(1<<bit) != 0
 }
 
 func (k Keeper) SetClaimed(ctx sdk.Context, mdId, index uint64) {
+	word, bit := types.ClaimedBitmapWordPosition(index)
+	k.setClaimedWord(ctx, mdId, word, k.getClaimedWord(ctx, mdId, word)|1<<bit)
+}
+
+func (k Keeper) getClaimedWord(ctx sdk.Context, mdId, word uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClaimedBitmapWordKey(mdId, word))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setClaimedWord(ctx sdk.Context, mdId, word, value uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ClaimedMerkledropIndexKey(mdId, index), []byte{0x01})
+	store.Set(types.ClaimedBitmapWordKey(mdId, word), sdk.Uint64ToBigEndian(value))
 }
 
 func (k Keeper) GetAllMerkleDrops(ctx sdk.Context) []types.Merkledrop {
@@ -90,7 +129,7 @@ func (k Keeper) getMerkleDropsByOwner(ctx sdk.Context, owner sdk.AccAddress) []t
 	store := ctx.KVStore(k.storeKey)
 
 	var merkledrops []types.Merkledrop
-	it := sdk.KVStorePrefixIterator(store, append(types.PrefixMerkleDropByOwner, owner...))
+	it := sdk.KVStorePrefixIterator(store, types.MerkledropOwnerPrefix(owner))
 	defer it.Close()
 
 	for ; it.Valid(); it.Next() {
@@ -130,16 +169,57 @@ func (k Keeper) iterateMerkledropIDByEndHeight(ctx sdk.Context, endHeight int64,
 	}
 }
 
+// GetMerkleDropsIDByEndTime returns the ids of the time-based merkledrops with an end time <= endTime
+func (k Keeper) GetMerkleDropsIDByEndTime(ctx sdk.Context, endTime time.Time) []uint64 {
+	var mdIDs []uint64
+	k.iterateMerkledropIDByEndTime(ctx, endTime, func(mdID uint64) (stop bool) {
+		mdIDs = append(mdIDs, mdID)
+		return false
+	})
+
+	return mdIDs
+}
+
+func (k Keeper) iterateMerkledropIDByEndTime(ctx sdk.Context, endTime time.Time, cb func(mdID uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.MerkledropEndTimePrefix(), sdk.PrefixEndBytes(types.MerkledropEndTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		mdID := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		if cb(mdID) {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateIndexByMerkledropID(ctx sdk.Context, mdId uint64, cb func(index uint64) bool) {
+	k.iterateClaimedWordsByMerkledropID(ctx, mdId, func(word, value uint64) bool {
+		for bit := uint64(0); bit < types.ClaimedBitmapWordSize; bit++ {
+			if value&(1<<bit) == 0 {
+				continue
+			}
+
+			if cb(word*types.ClaimedBitmapWordSize + bit) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (k Keeper) iterateClaimedWordsByMerkledropID(ctx sdk.Context, mdId uint64, cb func(word, value uint64) bool) {
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.ClaimedMerkledropKey(mdId)
+	prefix := types.ClaimedBitmapKey(mdId)
 	iterator := sdk.KVStorePrefixIterator(store, prefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		index := sdk.BigEndianToUint64(bytes.TrimPrefix(iterator.Key(), prefix))
+		word := sdk.BigEndianToUint64(bytes.TrimPrefix(iterator.Key(), prefix))
 
-		if cb(index) {
+		if cb(word, sdk.BigEndianToUint64(iterator.Value())) {
 			break
 		}
 	}
@@ -157,10 +237,15 @@ func (k Keeper) GetAllIndexesByMerkledropID(ctx sdk.Context, id uint64) []uint64
 
 func (k Keeper) deleteAllIndexesByMerkledropID(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
-	indexes := k.GetAllIndexesByMerkledropID(ctx, id)
 
-	for _, index := range indexes {
-		store.Delete(types.ClaimedMerkledropIndexKey(id, index))
+	var words []uint64
+	k.iterateClaimedWordsByMerkledropID(ctx, id, func(word, _ uint64) bool {
+		words = append(words, word)
+		return false
+	})
+
+	for _, word := range words {
+		store.Delete(types.ClaimedBitmapWordKey(id, word))
 	}
 }
 
@@ -182,8 +267,12 @@ func (k Keeper) DeleteMerkledropByID(ctx sdk.Context, id uint64) error {
 	// delete all indexes
 	k.deleteAllIndexesByMerkledropID(ctx, id)
 
-	// delete end-height key
-	store.Delete(types.MerkledropEndHeightAndIDKey(merkledrop.EndHeight, merkledrop.Id))
+	// delete end-time or end-height key
+	if merkledrop.IsTimeBased() {
+		store.Delete(types.MerkledropEndTimeAndIDKey(*merkledrop.EndTime, merkledrop.Id))
+	} else {
+		store.Delete(types.MerkledropEndHeightAndIDKey(merkledrop.EndHeight, merkledrop.Id))
+	}
 
 	// delete merkledrop
 	store.Delete(types.MerkledropKey(id))
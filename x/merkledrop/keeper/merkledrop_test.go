@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
 	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +72,55 @@ func (suite *KeeperTestSuite) TestKeeper_GetAllIndexById() {
 	assert.Equal(suite.T(), 3, len(allindexes[0].Index))
 	assert.Equal(suite.T(), 2, len(allindexes[1].Index))
 }
+
+func (suite *KeeperTestSuite) TestKeeper_ClaimedBitmapWords() {
+	suite.SetupTest()
+	ctx := suite.Ctx
+	mk := suite.App.MerkledropKeeper
+	store := ctx.KVStore(suite.App.GetKey(types.StoreKey))
+
+	for _, index := range []uint64{0, 63, 64, 1000} {
+		mk.SetClaimed(ctx, 1, index)
+	}
+
+	// 0 and 63 share the first word
+	assert.Equal(suite.T(), sdk.Uint64ToBigEndian(1|1<<63), store.Get(types.ClaimedBitmapWordKey(1, 0)))
+	assert.Equal(suite.T(), sdk.Uint64ToBigEndian(1), store.Get(types.ClaimedBitmapWordKey(1, 1)))
+	assert.Equal(suite.T(), sdk.Uint64ToBigEndian(1<<40), store.Get(types.ClaimedBitmapWordKey(1, 15)))
+	assert.False(suite.T(), mk.IsClaimed(ctx, 1, 62))
+	assert.False(suite.T(), mk.IsClaimed(ctx, 2, 0))
+	assert.Equal(suite.T(), []uint64{0, 63, 64, 1000}, mk.GetAllIndexesByMerkledropID(ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate2to3() {
+	suite.SetupTest()
+	ctx := suite.Ctx
+	mk := suite.App.MerkledropKeeper
+	store := ctx.KVStore(suite.App.GetKey(types.StoreKey))
+
+	legacy := map[uint64][]uint64{
+		1: {0, 5, 64, 200},
+		2: {7},
+	}
+	for id, indexes := range legacy {
+		for _, index := range indexes {
+			store.Set(types.ClaimedMerkledropIndexKey(id, index), []byte{0x01})
+		}
+	}
+
+	migrator := keeper.NewMigrator(mk)
+	suite.Require().NoError(migrator.Migrate2to3(ctx))
+
+	for id, indexes := range legacy {
+		suite.Require().Equal(indexes, mk.GetAllIndexesByMerkledropID(ctx, id))
+		for _, index := range indexes {
+			suite.Require().True(mk.IsClaimed(ctx, id, index))
+		}
+	}
+	suite.Require().False(mk.IsClaimed(ctx, 1, 1))
+
+	// the legacy keys have been deleted
+	it := sdk.KVStorePrefixIterator(store, types.ClaimedMerkledropPrefix())
+	defer it.Close()
+	suite.Require().False(it.Valid())
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the single denom merkledrops to the multi denom format:
+// the denom, amount and claimed fields are moved into coins and claimed_coins,
+// and the leaf version is set to 1. It also sets the withdraw grace params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyWithdrawGracePeriod, types.DefaultWithdrawGracePeriod)
+	m.keeper.paramSpace.Set(ctx, types.KeyWithdrawGraceDuration, types.DefaultWithdrawGraceDuration)
+
+	for _, merkledrop := range m.keeper.GetAllMerkleDrops(ctx) {
+		if merkledrop.LeafVersion != 0 {
+			continue
+		}
+
+		merkledrop.Coins = sdk.NewCoins(sdk.NewCoin(merkledrop.Denom, merkledrop.Amount))
+		merkledrop.ClaimedCoins = sdk.NewCoins(sdk.NewCoin(merkledrop.Denom, merkledrop.Claimed))
+		merkledrop.LeafVersion = types.LeafVersion1
+
+		merkledrop.Denom = ""
+		merkledrop.Amount = sdk.ZeroInt()
+		merkledrop.Claimed = sdk.ZeroInt()
+
+		if err := m.keeper.SetMerkleDrop(ctx, merkledrop); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the claimed indexes, stored as one key per index, to
+// bitmap words of 64 indexes per merkledrop.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	prefix := types.ClaimedMerkledropPrefix()
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// key: 0x04:<merkledropID_bytes>:<merkledropIndex>
+		parts := bytes.TrimPrefix(key, prefix)
+		if len(parts) != 17 {
+			return fmt.Errorf("invalid claimed index key: %X", key)
+		}
+
+		m.keeper.SetClaimed(ctx, sdk.BigEndianToUint64(parts[:8]), sdk.BigEndianToUint64(parts[9:]))
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates the creation fee, stored as a single coin, to the list
+// of accepted fees
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var fee sdk.Coin
+	if err := json.Unmarshal(m.keeper.paramSpace.GetRaw(ctx, types.KeyCreationFee), &fee); err != nil {
+		return err
+	}
+
+	m.keeper.paramSpace.Set(ctx, types.KeyCreationFee, []sdk.Coin{fee})
+
+	return nil
+}
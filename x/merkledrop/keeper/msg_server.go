@@ -22,52 +22,24 @@ func (m msgServer) Create(goCtx context.Context, msg *types.MsgCreate) (*types.M
 	// unwrap context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	startHeight := sdk.NewInt(msg.StartHeight)
-	endHeight := sdk.NewInt(msg.EndHeight)
-
-	// check end height and start height
-	if startHeight.IsNegative() {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidStartHeight, "start height must be greater then zero")
-	}
-
-	// check start height > current height
-	if startHeight.LT(sdk.NewInt(ctx.BlockHeight())) {
-		msg.StartHeight = ctx.BlockHeight()
-	}
-
-	// check end height and start height
-	if endHeight.LTE(startHeight) {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height must be > start height")
-	}
-
-	if endHeight.LTE(sdk.NewInt(ctx.BlockHeight())) {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height (%d) must be > current block height (%d)", msg.EndHeight, ctx.BlockHeight())
-	}
-
-	// add check startheight
-	// - max-start-height = blockheight + 100_000
-	maxStartHeight := ctx.BlockHeight() + int64(100_000)
-
-	// - max-end-height = msg.StartHeight + 5_000_000
-	maxEndHeight := msg.StartHeight + int64(5_000_000)
-
-	// start-height > max-start-height: return error
-	if startHeight.GT(sdk.NewInt(maxStartHeight)) {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidStartHeight, "start height is > block-height + 100000")
-	}
-
-	// end-height > max-end-height: return error
-	if endHeight.GT(sdk.NewInt(maxEndHeight)) {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height is > msg.StartHeight + 5000000")
+	if msg.IsTimeBased() {
+		if err := validateCreateTimes(ctx, msg); err != nil {
+			return &types.MsgCreateResponse{}, err
+		}
+	} else {
+		if err := validateCreateHeights(ctx, msg); err != nil {
+			return &types.MsgCreateResponse{}, err
+		}
 	}
 
-	// validate coin
-	if err := msg.Coin.Validate(); err != nil {
-		return &types.MsgCreateResponse{}, err
+	// validate coins
+	coins := msg.GetMerkledropCoins()
+	if err := coins.Validate(); err != nil {
+		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidCoin, "%s", err)
 	}
 
-	// check coin amount > 0
-	if msg.Coin.Amount.LTE(sdk.ZeroInt()) {
+	// check coins amount > 0
+	if coins.Empty() {
 		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidCoin, "invalid coin amount, must be greater then zero")
 	}
 
@@ -84,14 +56,14 @@ func (m msgServer) Create(goCtx context.Context, msg *types.MsgCreate) (*types.M
 	}
 
 	// deduct creation fee
-	if err = m.DeductCreationFee(ctx, owner); err != nil {
+	if err = m.DeductCreationFee(ctx, owner, msg.FeeDenom); err != nil {
 		return nil, err
 	}
 
 	// send coins
-	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.Coins{msg.Coin})
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins)
 	if err != nil {
-		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "%s", msg.Coin)
+		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "%s", coins)
 	}
 
 	// increment merkledrop id
@@ -100,14 +72,18 @@ func (m msgServer) Create(goCtx context.Context, msg *types.MsgCreate) (*types.M
 
 	// set merkledrop
 	merkledrop := types.Merkledrop{
-		Id:          mdId,
-		MerkleRoot:  msg.MerkleRoot,
-		StartHeight: msg.StartHeight,
-		EndHeight:   msg.EndHeight,
-		Amount:      msg.Coin.Amount,
-		Denom:       msg.Coin.Denom,
-		Claimed:     sdk.ZeroInt(),
-		Owner:       msg.Owner,
+		Id:           mdId,
+		MerkleRoot:   msg.MerkleRoot,
+		StartHeight:  msg.StartHeight,
+		EndHeight:    msg.EndHeight,
+		StartTime:    msg.StartTime,
+		EndTime:      msg.EndTime,
+		Amount:       sdk.ZeroInt(),
+		Claimed:      sdk.ZeroInt(),
+		Owner:        msg.Owner,
+		Coins:        coins,
+		ClaimedCoins: sdk.NewCoins(),
+		LeafVersion:  msg.GetLeafVersion(),
 	}
 	if err := m.Keeper.SetMerkleDrop(ctx, merkledrop); err != nil {
 		return &types.MsgCreateResponse{}, sdkerrors.Wrapf(types.ErrInvalidSender, "sender %s", owner.String())
@@ -125,6 +101,83 @@ func (m msgServer) Create(goCtx context.Context, msg *types.MsgCreate) (*types.M
 	}, nil
 }
 
+// validateCreateHeights checks the window of a height-based merkledrop,
+// setting the start height to the current height if it is in the past
+func validateCreateHeights(ctx sdk.Context, msg *types.MsgCreate) error {
+	startHeight := sdk.NewInt(msg.StartHeight)
+	endHeight := sdk.NewInt(msg.EndHeight)
+
+	// check end height and start height
+	if startHeight.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidStartHeight, "start height must be greater then zero")
+	}
+
+	// check start height > current height
+	if startHeight.LT(sdk.NewInt(ctx.BlockHeight())) {
+		msg.StartHeight = ctx.BlockHeight()
+	}
+
+	// check end height and start height
+	if endHeight.LTE(startHeight) {
+		return sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height must be > start height")
+	}
+
+	if endHeight.LTE(sdk.NewInt(ctx.BlockHeight())) {
+		return sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height (%d) must be > current block height (%d)", msg.EndHeight, ctx.BlockHeight())
+	}
+
+	// add check startheight
+	// - max-start-height = blockheight + 100_000
+	maxStartHeight := ctx.BlockHeight() + int64(100_000)
+
+	// - max-end-height = msg.StartHeight + 5_000_000
+	maxEndHeight := msg.StartHeight + int64(5_000_000)
+
+	// start-height > max-start-height: return error
+	if startHeight.GT(sdk.NewInt(maxStartHeight)) {
+		return sdkerrors.Wrapf(types.ErrInvalidStartHeight, "start height is > block-height + 100000")
+	}
+
+	// end-height > max-end-height: return error
+	if endHeight.GT(sdk.NewInt(maxEndHeight)) {
+		return sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height is > msg.StartHeight + 5000000")
+	}
+
+	return nil
+}
+
+// validateCreateTimes checks the window of a time-based merkledrop,
+// setting the start time to the current block time if it is missing or in the past
+func validateCreateTimes(ctx sdk.Context, msg *types.MsgCreate) error {
+	blockTime := ctx.BlockTime()
+
+	// check start time > current time
+	if msg.StartTime == nil || msg.StartTime.Before(blockTime) {
+		msg.StartTime = &blockTime
+	}
+
+	// check end time and start time
+	if !msg.EndTime.After(*msg.StartTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidEndTime, "end time must be > start time")
+	}
+
+	// start-time > block-time + max-start-time-delay: return error
+	if msg.StartTime.After(blockTime.Add(types.MaxStartTimeDelay)) {
+		return sdkerrors.Wrapf(types.ErrInvalidStartTime, "start time is > block-time + %s", types.MaxStartTimeDelay)
+	}
+
+	// end-time > start-time + max-duration: return error
+	if msg.EndTime.After(msg.StartTime.Add(types.MaxDuration)) {
+		return sdkerrors.Wrapf(types.ErrInvalidEndTime, "end time is > start-time + %s", types.MaxDuration)
+	}
+
+	// the heights are not used by the time-based merkledrops
+	msg.StartHeight = 0
+	msg.EndHeight = 0
+
+	return nil
+}
+
 func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
 	// unwrap context
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -141,17 +194,14 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotExist, "merkledrop: %d does not exist", msg.MerkledropId)
 	}
 
-	startHeight := sdk.NewInt(merkledrop.StartHeight)
-	endHeight := sdk.NewInt(merkledrop.EndHeight)
-
 	// merkledrop begun
-	if startHeight.GT(sdk.NewInt(ctx.BlockHeight())) {
-		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotBegun, "start-height %d, current-height %d", merkledrop.StartHeight, ctx.BlockHeight())
+	if !merkledrop.HasBegun(ctx.BlockHeight(), ctx.BlockTime()) {
+		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotBegun, "merkledrop %d", merkledrop.Id)
 	}
 
 	// merkledrop not expired, last block is included
-	if endHeight.LTE(sdk.NewInt(ctx.BlockHeight())) {
-		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrMerkledropExpired, "end-height %d, current-height %d", merkledrop.EndHeight, ctx.BlockHeight())
+	if merkledrop.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrMerkledropExpired, "merkledrop %d", merkledrop.Id)
 	}
 
 	// check if is claimed
@@ -160,40 +210,51 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrAlreadyClaimed, "merkledrop_id (%d)", msg.MerkledropId)
 	}
 
-	// check and decode merkle root
-	merkleRoot, err := hex.DecodeString(merkledrop.GetMerkleRoot())
+	// the sender can claim on behalf of the beneficiary of the leaf
+	beneficiary, err := sdk.AccAddressFromBech32(msg.GetBeneficiaryOrSender())
+	if err != nil {
+		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrInvalidBeneficiary, "beneficiary %s", msg.Beneficiary)
+	}
+
+	// a recipient other than the beneficiary must be authorized by its signature
+	recipient, err := sdk.AccAddressFromBech32(msg.GetRecipientOrBeneficiary())
 	if err != nil {
-		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrInvalidMerkleRoot, "invalid merkle root (%s)", err)
+		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrInvalidRecipient, "recipient %s", msg.Recipient)
+	}
+
+	if !recipient.Equals(beneficiary) {
+		err := types.VerifyRecipientAuthorization(ctx.ChainID(), msg.MerkledropId, msg.Index, beneficiary, recipient, msg.BeneficiaryPubKey, msg.RecipientSignature)
+		if err != nil {
+			return &types.MsgClaimResponse{}, err
+		}
 	}
 
 	// verify proofs
 	proofs := types.ConvertProofs(msg.Proofs)
-	valid := types.IsValidProof(msg.Index, sender, msg.Amount, merkleRoot, proofs)
-	if !valid {
-		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrInvalidMerkleProofs, "invalid proofs")
+	coins, err := merkledrop.VerifyClaim(msg.Index, beneficiary, msg.Amount, msg.Coins, proofs)
+	if err != nil {
+		return &types.MsgClaimResponse{}, err
 	}
 
-	amtAvailable := merkledrop.Amount.Sub(merkledrop.Claimed)
-	if amtAvailable.LT(msg.Amount) {
+	if !merkledrop.GetUnclaimedCoins().IsAllGTE(coins) {
 		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "something went wrong")
 	}
 
 	// send coins
-	coin := sdk.NewCoin(merkledrop.Denom, msg.Amount)
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{coin})
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
 	if err != nil {
-		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "%s%s", msg.Amount, merkledrop.Denom)
+		return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "%s", coins)
 	}
 
 	// set claimed
 	m.Keeper.SetClaimed(ctx, msg.MerkledropId, msg.Index)
 
-	// add claimed amount
-	merkledrop.Claimed = merkledrop.Claimed.Add(msg.Amount)
+	// add claimed coins
+	merkledrop.ClaimedCoins = merkledrop.ClaimedCoins.Add(coins...)
 	m.Keeper.SetMerkleDrop(ctx, merkledrop)
 
-	// if claimed amount == total amount, then prune the merkledrop from the state
-	if merkledrop.Claimed.Equal(merkledrop.Amount) {
+	// if claimed coins == total coins, then prune the merkledrop from the state
+	if merkledrop.IsFullyClaimed() {
 		err := m.Keeper.DeleteMerkledropByID(ctx, merkledrop.Id)
 		if err != nil {
 			return &types.MsgClaimResponse{}, sdkerrors.Wrapf(types.ErrDeleteMerkledrop, err.Error())
@@ -204,12 +265,206 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 	ctx.EventManager().EmitTypedEvent(&types.EventClaim{
 		MerkledropId: merkledrop.Id,
 		Index:        msg.Index,
-		Coin:         coin,
+		Coins:        coins,
+		Beneficiary:  beneficiary.String(),
+		Recipient:    recipient.String(),
 	})
 
+	// the amount is set only for the single denom merkledrops
+	amount := sdk.ZeroInt()
+	if merkledrop.LeafVersion == types.LeafVersion1 {
+		amount = coins[0].Amount
+	}
+
 	return &types.MsgClaimResponse{
 		Id:     0,
 		Index:  0,
-		Amount: msg.Amount,
+		Amount: amount,
+		Coins:  coins,
+	}, nil
+}
+
+func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
+	// unwrap context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get merkledrop
+	merkledrop, err := m.Keeper.getMerkleDropById(ctx, msg.MerkledropId)
+	if err != nil {
+		return &types.MsgWithdrawResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotExist, "merkledrop: %d does not exist", msg.MerkledropId)
+	}
+
+	// check owner
+	if merkledrop.Owner != msg.Owner {
+		return &types.MsgWithdrawResponse{}, sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is not the owner of merkledrop %d", msg.Owner, msg.MerkledropId)
+	}
+
+	// the owner can withdraw before the start, or once the grace period is elapsed
+	params := m.Keeper.GetParamSet(ctx)
+	if merkledrop.IsTimeBased() {
+		if merkledrop.HasBegun(ctx.BlockHeight(), ctx.BlockTime()) && ctx.BlockTime().Before(merkledrop.StartTime.Add(params.WithdrawGraceDuration)) {
+			return &types.MsgWithdrawResponse{}, sdkerrors.Wrapf(types.ErrWithdrawNotAllowed, "withdraw allowed from time %s, current-time %s", merkledrop.StartTime.Add(params.WithdrawGraceDuration), ctx.BlockTime())
+		}
+	} else {
+		gracePeriod := params.WithdrawGracePeriod
+		if ctx.BlockHeight() >= merkledrop.StartHeight && ctx.BlockHeight() < merkledrop.StartHeight+gracePeriod {
+			return &types.MsgWithdrawResponse{}, sdkerrors.Wrapf(types.ErrWithdrawNotAllowed, "withdraw allowed from height %d, current-height %d", merkledrop.StartHeight+gracePeriod, ctx.BlockHeight())
+		}
+	}
+
+	coins := merkledrop.GetUnclaimedCoins()
+
+	// send the unclaimed coins to the owner
+	if err := m.Keeper.Withdraw(ctx, merkledrop.Id); err != nil {
+		return &types.MsgWithdrawResponse{}, err
+	}
+
+	// prune the merkledrop from the state
+	if err := m.Keeper.DeleteMerkledropByID(ctx, merkledrop.Id); err != nil {
+		return &types.MsgWithdrawResponse{}, sdkerrors.Wrapf(types.ErrDeleteMerkledrop, err.Error())
+	}
+
+	return &types.MsgWithdrawResponse{
+		Id:    merkledrop.Id,
+		Coins: coins,
 	}, nil
 }
+
+func (m msgServer) Fund(goCtx context.Context, msg *types.MsgFund) (*types.MsgFundResponse, error) {
+	// unwrap context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get merkledrop
+	merkledrop, err := m.Keeper.getMerkleDropById(ctx, msg.MerkledropId)
+	if err != nil {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotExist, "merkledrop: %d does not exist", msg.MerkledropId)
+	}
+
+	// check owner
+	if merkledrop.Owner != msg.Owner {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is not the owner of merkledrop %d", msg.Owner, msg.MerkledropId)
+	}
+
+	// merkledrop not expired
+	if merkledrop.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrMerkledropExpired, "merkledrop %d", merkledrop.Id)
+	}
+
+	// only the denoms of the merkledrop can be deposited
+	if !msg.Coins.DenomsSubsetOf(merkledrop.Coins) {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrInvalidCoin, "coins %s must be a subset of the merkledrop denoms %s", msg.Coins, merkledrop.Coins)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrInvalidOwner, "owner %s", msg.Owner)
+	}
+
+	// send coins
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, msg.Coins)
+	if err != nil {
+		return &types.MsgFundResponse{}, sdkerrors.Wrapf(types.ErrTransferCoins, "%s", msg.Coins)
+	}
+
+	merkledrop.Coins = merkledrop.Coins.Add(msg.Coins...)
+	if err := m.Keeper.SetMerkleDrop(ctx, merkledrop); err != nil {
+		return &types.MsgFundResponse{}, err
+	}
+
+	// emit event
+	ctx.EventManager().EmitTypedEvent(&types.EventFund{
+		MerkledropId: merkledrop.Id,
+		Coins:        msg.Coins,
+	})
+
+	return &types.MsgFundResponse{}, nil
+}
+
+func (m msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.MsgExtendResponse, error) {
+	// unwrap context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// get merkledrop
+	merkledrop, err := m.Keeper.getMerkleDropById(ctx, msg.MerkledropId)
+	if err != nil {
+		return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrMerkledropNotExist, "merkledrop: %d does not exist", msg.MerkledropId)
+	}
+
+	// check owner
+	if merkledrop.Owner != msg.Owner {
+		return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is not the owner of merkledrop %d", msg.Owner, msg.MerkledropId)
+	}
+
+	// merkledrop not expired
+	if merkledrop.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrMerkledropExpired, "merkledrop %d", merkledrop.Id)
+	}
+
+	if merkledrop.IsTimeBased() {
+		if msg.EndTime == nil {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndTime, "the merkledrop %d is time-based", merkledrop.Id)
+		}
+
+		// the end time can only be pushed out
+		if !msg.EndTime.After(*merkledrop.EndTime) {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndTime, "end time must be > current end time (%s)", merkledrop.EndTime)
+		}
+
+		// end-time > start-time + max-duration: return error
+		if msg.EndTime.After(merkledrop.StartTime.Add(types.MaxDuration)) {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndTime, "end time is > start-time + %s", types.MaxDuration)
+		}
+
+		if err := m.Keeper.SetMerkleDropEndTime(ctx, merkledrop, *msg.EndTime); err != nil {
+			return &types.MsgExtendResponse{}, err
+		}
+	} else {
+		if msg.EndTime != nil {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "the merkledrop %d is height-based", merkledrop.Id)
+		}
+
+		// the end height can only be pushed out
+		if msg.EndHeight <= merkledrop.EndHeight {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height must be > current end height (%d)", merkledrop.EndHeight)
+		}
+
+		// - max-end-height = merkledrop.StartHeight + 5_000_000
+		maxEndHeight := merkledrop.StartHeight + int64(5_000_000)
+
+		// end-height > max-end-height: return error
+		if msg.EndHeight > maxEndHeight {
+			return &types.MsgExtendResponse{}, sdkerrors.Wrapf(types.ErrInvalidEndHeight, "end height is > merkledrop.StartHeight + 5000000")
+		}
+
+		if err := m.Keeper.SetMerkleDropEndHeight(ctx, merkledrop, msg.EndHeight); err != nil {
+			return &types.MsgExtendResponse{}, err
+		}
+	}
+
+	// emit event
+	ctx.EventManager().EmitTypedEvent(&types.EventExtend{
+		MerkledropId: merkledrop.Id,
+		EndHeight:    msg.EndHeight,
+		EndTime:      msg.EndTime,
+	})
+
+	return &types.MsgExtendResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// unwrap context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only the authority can update the params
+	if m.Keeper.GetAuthority() != msg.Authority {
+		return &types.MsgUpdateParamsResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return &types.MsgUpdateParamsResponse{}, err
+	}
+
+	m.Keeper.SetParamSet(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,489 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/client/cli"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) fundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) getMerkledrop(id uint64) (types.Merkledrop, error) {
+	res, err := suite.App.MerkledropKeeper.Merkledrop(sdk.WrapSDKContext(suite.Ctx), &types.QueryMerkledropRequest{Id: id})
+	if err != nil {
+		return types.Merkledrop{}, err
+	}
+	return res.Merkledrop, nil
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_CreateAndClaimCoins() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+
+	accs := map[string]string{
+		suite.TestAccs[1].String(): "1000ubtsg,10ftfoo",
+		suite.TestAccs[2].String(): "2000ubtsg",
+	}
+	accMap, err := cli.AccountsFromCoinsMap(accs)
+	suite.Require().NoError(err)
+
+	tree, claimInfo, totalCoins, err := cli.CreateCoinsDistributionList(accMap)
+	suite.Require().NoError(err)
+	suite.Require().Equal("10ftfoo,3000ubtsg", totalCoins.String())
+
+	creationFee := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx).CreationFee[0]
+	suite.fundAccount(owner, totalCoins.Add(creationFee))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, fmt.Sprintf("%x", tree.Root()), suite.Ctx.BlockHeight(), suite.Ctx.BlockHeight()+100, totalCoins,
+	))
+	suite.Require().NoError(err)
+
+	merkledrop, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LeafVersion2, merkledrop.LeafVersion)
+	suite.Require().Equal(totalCoins, merkledrop.Coins)
+	suite.Require().True(merkledrop.ClaimedCoins.Empty())
+
+	// claiming different coins must fail
+	info := claimInfo[suite.TestAccs[1].String()]
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000)), info.Proof, suite.TestAccs[1],
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidMerkleProofs)
+
+	// a multi denom merkledrop cannot be claimed with the amount
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaim(
+		info.Index, res.Id, sdk.NewInt(1000), info.Proof, suite.TestAccs[1],
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidCoin)
+
+	// claim the coins of the first account
+	coins, err := sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+	claimRes, err := msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, coins, info.Proof, suite.TestAccs[1],
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, claimRes.Coins)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1]))
+
+	merkledrop, err = suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, merkledrop.ClaimedCoins)
+
+	// claim the coins of the second account, the merkledrop is pruned
+	info = claimInfo[suite.TestAccs[2].String()]
+	coins, err = sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, coins, info.Proof, suite.TestAccs[2],
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[2]))
+
+	_, err = suite.getMerkledrop(res.Id)
+	suite.Require().ErrorIs(err, types.ErrMerkledropNotExist)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_ClaimSingleDenomWithCoins() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+
+	accs := map[string]string{
+		suite.TestAccs[1].String(): "1000",
+		suite.TestAccs[2].String(): "2000",
+	}
+	accMap, err := cli.AccountsFromMap(accs)
+	suite.Require().NoError(err)
+
+	tree, claimInfo, totalAmt, err := cli.CreateDistributionList(accMap)
+	suite.Require().NoError(err)
+
+	coin := sdk.NewCoin("ubtsg", totalAmt)
+	creationFee := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx).CreationFee[0]
+	suite.fundAccount(owner, sdk.NewCoins(coin, creationFee))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreate(
+		owner, fmt.Sprintf("%x", tree.Root()), suite.Ctx.BlockHeight(), suite.Ctx.BlockHeight()+100, coin,
+	))
+	suite.Require().NoError(err)
+
+	merkledrop, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LeafVersion1, merkledrop.LeafVersion)
+
+	// a single denom merkledrop can be claimed with the amount
+	info := claimInfo[suite.TestAccs[1].String()]
+	amt, ok := sdk.NewIntFromString(info.Amount)
+	suite.Require().True(ok)
+	claimRes, err := msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaim(
+		info.Index, res.Id, amt, info.Proof, suite.TestAccs[1],
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(amt, claimRes.Amount)
+
+	// or with a single coin of its denom
+	info = claimInfo[suite.TestAccs[2].String()]
+	amt, ok = sdk.NewIntFromString(info.Amount)
+	suite.Require().True(ok)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, sdk.NewCoins(sdk.NewCoin("ustake", amt)), info.Proof, suite.TestAccs[2],
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidCoin)
+
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), types.NewMsgClaimWithCoins(
+		info.Index, res.Id, sdk.NewCoins(sdk.NewCoin("ubtsg", amt)), info.Proof, suite.TestAccs[2],
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(amt, suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], "ubtsg").Amount)
+}
+
+func (suite *KeeperTestSuite) TestMigrator_Migrate1to2() {
+	suite.SetupTest()
+	ctx := suite.Ctx
+	mk := suite.App.MerkledropKeeper
+
+	legacy := types.Merkledrop{
+		Id:          1,
+		MerkleRoot:  "sdsd",
+		StartHeight: int64(10),
+		EndHeight:   int64(20),
+		Denom:       "ubtsg",
+		Amount:      sdk.NewInt(100),
+		Claimed:     sdk.NewInt(40),
+		Owner:       suite.TestAccs[0].String(),
+	}
+	suite.Require().NoError(mk.SetMerkleDrop(ctx, legacy))
+
+	migrator := keeper.NewMigrator(mk)
+	suite.Require().NoError(migrator.Migrate1to2(ctx))
+
+	merkledrop, err := suite.getMerkledrop(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LeafVersion1, merkledrop.LeafVersion)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 100)), merkledrop.Coins)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 40)), merkledrop.ClaimedCoins)
+	suite.Require().Equal("", merkledrop.Denom)
+	suite.Require().True(merkledrop.Amount.IsZero())
+	suite.Require().NoError(merkledrop.ValidateCoins())
+	suite.Require().Equal(types.DefaultWithdrawGracePeriod, mk.GetParamSet(ctx).WithdrawGracePeriod)
+	suite.Require().Equal(types.DefaultWithdrawGraceDuration, mk.GetParamSet(ctx).WithdrawGraceDuration)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_Withdraw() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+	params := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000), sdk.NewInt64Coin("ftfoo", 10))
+	height := suite.Ctx.BlockHeight()
+
+	suite.fundAccount(owner, coins.Add(coins...).Add(params.CreationFee[0]).Add(params.CreationFee[0]))
+
+	// merkledrop not begun
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, "a258c32bee9b0bbb7a2d1999ab4698294844e7440aa6dcd067e0d5142fa20522", height+10, height+1000, coins,
+	))
+	suite.Require().NoError(err)
+	balance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner)
+
+	_, err = msgSrv.Withdraw(sdk.WrapSDKContext(suite.Ctx), types.NewMsgWithdraw(suite.TestAccs[1], res.Id))
+	suite.Require().ErrorIs(err, types.ErrInvalidOwner)
+
+	withdrawRes, err := msgSrv.Withdraw(sdk.WrapSDKContext(suite.Ctx), types.NewMsgWithdraw(owner, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, withdrawRes.Coins)
+	suite.Require().Equal(balance.Add(coins...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner))
+
+	_, err = suite.getMerkledrop(res.Id)
+	suite.Require().ErrorIs(err, types.ErrMerkledropNotExist)
+	suite.Require().Empty(suite.App.MerkledropKeeper.GetMerkleDropsIDByEndHeight(suite.Ctx, height+1000))
+
+	// merkledrop begun, the owner must wait for the grace period
+	res, err = msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, "a258c32bee9b0bbb7a2d1999ab4698294844e7440aa6dcd067e0d5142fa20522", height, height+params.WithdrawGracePeriod+1000, coins,
+	))
+	suite.Require().NoError(err)
+	balance = suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner)
+
+	_, err = msgSrv.Withdraw(sdk.WrapSDKContext(suite.Ctx), types.NewMsgWithdraw(owner, res.Id))
+	suite.Require().ErrorIs(err, types.ErrWithdrawNotAllowed)
+
+	ctx := suite.Ctx.WithBlockHeight(height + params.WithdrawGracePeriod - 1)
+	_, err = msgSrv.Withdraw(sdk.WrapSDKContext(ctx), types.NewMsgWithdraw(owner, res.Id))
+	suite.Require().ErrorIs(err, types.ErrWithdrawNotAllowed)
+
+	ctx = suite.Ctx.WithBlockHeight(height + params.WithdrawGracePeriod)
+	withdrawRes, err = msgSrv.Withdraw(sdk.WrapSDKContext(ctx), types.NewMsgWithdraw(owner, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, withdrawRes.Coins)
+	suite.Require().Equal(balance.Add(coins...), suite.App.BankKeeper.GetAllBalances(ctx, owner))
+
+	_, err = suite.getMerkledrop(res.Id)
+	suite.Require().ErrorIs(err, types.ErrMerkledropNotExist)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_FundAndExtend() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+	params := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000), sdk.NewInt64Coin("ftfoo", 10))
+	height := suite.Ctx.BlockHeight()
+
+	suite.fundAccount(owner, coins.Add(coins...).Add(sdk.NewInt64Coin("ftbar", 10)).Add(params.CreationFee[0]))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, "a258c32bee9b0bbb7a2d1999ab4698294844e7440aa6dcd067e0d5142fa20522", height, height+1000, coins,
+	))
+	suite.Require().NoError(err)
+
+	// fund
+	_, err = msgSrv.Fund(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFund(suite.TestAccs[1], res.Id, coins))
+	suite.Require().ErrorIs(err, types.ErrInvalidOwner)
+
+	_, err = msgSrv.Fund(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFund(owner, res.Id, sdk.NewCoins(sdk.NewInt64Coin("ftbar", 10))))
+	suite.Require().ErrorIs(err, types.ErrInvalidCoin)
+
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 500))
+	_, err = msgSrv.Fund(sdk.WrapSDKContext(suite.Ctx), types.NewMsgFund(owner, res.Id, topUp))
+	suite.Require().NoError(err)
+
+	merkledrop, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Add(topUp...), merkledrop.Coins)
+
+	// extend
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(suite.Ctx), types.NewMsgExtend(suite.TestAccs[1], res.Id, height+2000))
+	suite.Require().ErrorIs(err, types.ErrInvalidOwner)
+
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(suite.Ctx), types.NewMsgExtend(owner, res.Id, height+1000))
+	suite.Require().ErrorIs(err, types.ErrInvalidEndHeight)
+
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(suite.Ctx), types.NewMsgExtend(owner, res.Id, height+5_000_001))
+	suite.Require().ErrorIs(err, types.ErrInvalidEndHeight)
+
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(suite.Ctx), types.NewMsgExtend(owner, res.Id, height+5_000_000))
+	suite.Require().NoError(err)
+
+	merkledrop, err = suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(height+5_000_000, merkledrop.EndHeight)
+	suite.Require().Empty(suite.App.MerkledropKeeper.GetMerkleDropsIDByEndHeight(suite.Ctx, height+1000))
+	suite.Require().Equal([]uint64{res.Id}, suite.App.MerkledropKeeper.GetMerkleDropsIDByEndHeight(suite.Ctx, height+5_000_000))
+
+	// expired merkledrop
+	ctx := suite.Ctx.WithBlockHeight(height + 5_000_000)
+	_, err = msgSrv.Fund(sdk.WrapSDKContext(ctx), types.NewMsgFund(owner, res.Id, topUp))
+	suite.Require().ErrorIs(err, types.ErrMerkledropExpired)
+
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(ctx), types.NewMsgExtend(owner, res.Id, height+5_000_000))
+	suite.Require().ErrorIs(err, types.ErrMerkledropExpired)
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_TimeBased() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+	params := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx)
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(blockTime)
+
+	accs := map[string]string{
+		suite.TestAccs[1].String(): "1000ubtsg",
+		suite.TestAccs[2].String(): "2000ubtsg",
+	}
+	accMap, err := cli.AccountsFromCoinsMap(accs)
+	suite.Require().NoError(err)
+
+	tree, claimInfo, totalCoins, err := cli.CreateCoinsDistributionList(accMap)
+	suite.Require().NoError(err)
+
+	suite.fundAccount(owner, totalCoins.Add(params.CreationFee[0]))
+
+	startTime := blockTime.Add(time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	// end time out of the window
+	msg := types.NewMsgCreateWithCoins(owner, fmt.Sprintf("%x", tree.Root()), 0, 0, totalCoins)
+	tooLate := startTime.Add(types.MaxDuration + time.Second)
+	msg.StartTime, msg.EndTime = &startTime, &tooLate
+	_, err = msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidEndTime)
+
+	msg = types.NewMsgCreateWithCoins(owner, fmt.Sprintf("%x", tree.Root()), 0, 0, totalCoins)
+	msg.StartTime, msg.EndTime = &startTime, &endTime
+	suite.Require().NoError(msg.ValidateBasic())
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+
+	md, err := suite.getMerkledrop(res.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(md.IsTimeBased())
+	suite.Require().Equal(startTime, *md.StartTime)
+	suite.Require().Equal(endTime, *md.EndTime)
+	suite.Require().Equal([]uint64{res.Id}, suite.App.MerkledropKeeper.GetMerkleDropsIDByEndTime(suite.Ctx, endTime))
+	suite.Require().Empty(suite.App.MerkledropKeeper.GetMerkleDropsIDByEndTime(suite.Ctx, endTime.Add(-time.Second)))
+
+	// not begun
+	info := claimInfo[suite.TestAccs[1].String()]
+	coins, err := sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+	claimMsg := types.NewMsgClaimWithCoins(info.Index, res.Id, coins, info.Proof, suite.TestAccs[1])
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), claimMsg)
+	suite.Require().ErrorIs(err, types.ErrMerkledropNotBegun)
+
+	// begun, the heights are ignored
+	ctx := suite.Ctx.WithBlockTime(startTime).WithBlockHeight(suite.Ctx.BlockHeight() + 5_000_000)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(ctx), claimMsg)
+	suite.Require().NoError(err)
+
+	// the owner must wait for the grace duration
+	_, err = msgSrv.Withdraw(sdk.WrapSDKContext(ctx), types.NewMsgWithdraw(owner, res.Id))
+	suite.Require().ErrorIs(err, types.ErrWithdrawNotAllowed)
+
+	// extend
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(ctx), types.NewMsgExtend(owner, res.Id, ctx.BlockHeight()+100))
+	suite.Require().ErrorIs(err, types.ErrInvalidEndTime)
+
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(ctx), types.NewMsgExtendTime(owner, res.Id, startTime.Add(types.MaxDuration+time.Second)))
+	suite.Require().ErrorIs(err, types.ErrInvalidEndTime)
+
+	newEndTime := endTime.Add(24 * time.Hour)
+	_, err = msgSrv.Extend(sdk.WrapSDKContext(ctx), types.NewMsgExtendTime(owner, res.Id, newEndTime))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.MerkledropKeeper.GetMerkleDropsIDByEndTime(ctx, endTime))
+
+	// expired at the first block with time >= end time
+	ctx = ctx.WithBlockTime(newEndTime)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(ctx), claimMsg)
+	suite.Require().ErrorIs(err, types.ErrMerkledropExpired)
+
+	balance := suite.App.BankKeeper.GetAllBalances(ctx, owner)
+	merkledrop.EndBlocker(ctx, suite.App.MerkledropKeeper)
+
+	_, err = suite.getMerkledrop(res.Id)
+	suite.Require().ErrorIs(err, types.ErrMerkledropNotExist)
+	suite.Require().Empty(suite.App.MerkledropKeeper.GetMerkleDropsIDByEndTime(ctx, newEndTime))
+	suite.Require().Equal(balance.Add(totalCoins.Sub(coins)...), suite.App.BankKeeper.GetAllBalances(ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_ClaimOnBehalf() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	owner := suite.TestAccs[0]
+	relayer := suite.TestAccs[1]
+	recipient := suite.TestAccs[2]
+
+	beneficiaryKey := secp256k1.GenPrivKey()
+	beneficiary := sdk.AccAddress(beneficiaryKey.PubKey().Address())
+	otherKey := secp256k1.GenPrivKey()
+	other := sdk.AccAddress(otherKey.PubKey().Address())
+
+	accs := map[string]string{
+		beneficiary.String(): "1000ubtsg",
+		other.String():       "2000ubtsg",
+	}
+	accMap, err := cli.AccountsFromCoinsMap(accs)
+	suite.Require().NoError(err)
+
+	tree, claimInfo, totalCoins, err := cli.CreateCoinsDistributionList(accMap)
+	suite.Require().NoError(err)
+
+	creationFee := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx).CreationFee[0]
+	suite.fundAccount(owner, totalCoins.Add(creationFee))
+
+	res, err := msgSrv.Create(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateWithCoins(
+		owner, fmt.Sprintf("%x", tree.Root()), suite.Ctx.BlockHeight(), suite.Ctx.BlockHeight()+100, totalCoins,
+	))
+	suite.Require().NoError(err)
+
+	// the relayer claims on behalf of the beneficiary, the coins go to the beneficiary
+	info := claimInfo[beneficiary.String()]
+	coins, err := sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgClaimWithCoins(info.Index, res.Id, coins, info.Proof, relayer)
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidMerkleProofs)
+
+	msg.Beneficiary = beneficiary.String()
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, beneficiary))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, relayer).Empty())
+
+	// the recipient of the other leaf must be authorized by its key
+	info = claimInfo[other.String()]
+	coins, err = sdk.ParseCoinsNormalized(info.Coins)
+	suite.Require().NoError(err)
+
+	msg = types.NewMsgClaimWithCoins(info.Index, res.Id, coins, info.Proof, relayer)
+	msg.Beneficiary = other.String()
+	msg.Recipient = recipient.String()
+	suite.Require().ErrorIs(msg.ValidateBasic(), types.ErrInvalidSignature)
+
+	// a signature from a key other than the beneficiary one is rejected
+	signBytes := types.RecipientAuthorizationSignBytes(suite.Ctx.ChainID(), res.Id, info.Index, recipient)
+	msg.RecipientSignature, err = beneficiaryKey.Sign(signBytes)
+	suite.Require().NoError(err)
+	msg.BeneficiaryPubKey = beneficiaryKey.PubKey().Bytes()
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+	suite.Require().False(suite.App.MerkledropKeeper.IsClaimed(suite.Ctx, res.Id, info.Index))
+
+	// the signature is bound to the recipient
+	msg.RecipientSignature, err = otherKey.Sign(signBytes)
+	suite.Require().NoError(err)
+	msg.BeneficiaryPubKey = otherKey.PubKey().Bytes()
+	msg.Recipient = relayer.String()
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	msg.Recipient = recipient.String()
+	_, err = msgSrv.Claim(sdk.WrapSDKContext(suite.Ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, recipient))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, other).Empty())
+}
+
+func (suite *KeeperTestSuite) TestMsgServer_UpdateParams() {
+	suite.SetupTest()
+	msgSrv := keeper.NewMsgServerImpl(suite.App.MerkledropKeeper)
+	authority := suite.App.MerkledropKeeper.GetAuthority()
+
+	defaultParams := suite.App.MerkledropKeeper.GetParamSet(suite.Ctx)
+	params := types.NewParams([]sdk.Coin{sdk.NewInt64Coin("ubtsg", 10)}, 1_000, time.Hour)
+
+	// only the authority can update the params
+	_, err := msgSrv.UpdateParams(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUpdateParams(params, suite.TestAccs[0].String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(defaultParams, suite.App.MerkledropKeeper.GetParamSet(suite.Ctx))
+
+	// the params are validated
+	invalid := params
+	invalid.WithdrawGracePeriod = -1
+	_, err = msgSrv.UpdateParams(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUpdateParams(invalid, authority))
+	suite.Require().Error(err)
+
+	// all the params are replaced at once
+	_, err = msgSrv.UpdateParams(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUpdateParams(params, authority))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.App.MerkledropKeeper.GetParamSet(suite.Ctx))
+}
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding merkledrop type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PrefixMerkleDrop):
+			var merkledropA, merkledropB types.Merkledrop
+			cdc.MustUnmarshal(kvA.Value, &merkledropA)
+			cdc.MustUnmarshal(kvB.Value, &merkledropB)
+			return fmt.Sprintf("%v\n%v", merkledropA, merkledropB)
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixMerkleDropByOwner),
+			bytes.Equal(kvA.Key[:1], types.PrefixClaimedBitmap),
+			bytes.Equal(kvA.Key[:1], types.KeyLastMerkleDropId):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.PrefixMerkleDropByEndHeight),
+			bytes.Equal(kvA.Key[:1], types.PrefixMerkleDropByEndTime),
+			bytes.Equal(kvA.Key[:1], types.PrefixClaimedMerkleDrop):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid merkledrop key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
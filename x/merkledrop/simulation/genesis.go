@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+)
+
+// Simulation parameter constants
+const (
+	CreationFee           = "creation_fee"
+	WithdrawGracePeriod   = "withdraw_grace_period"
+	WithdrawGraceDuration = "withdraw_grace_duration"
+)
+
+// GenCreationFee randomizes the accepted creation fees: none, or a fee in the bond denom
+func GenCreationFee(r *rand.Rand) []sdk.Coin {
+	if r.Intn(10) == 0 {
+		return []sdk.Coin{}
+	}
+	return []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1_000_000))}
+}
+
+// GenWithdrawGracePeriod randomizes the withdraw grace period
+func GenWithdrawGracePeriod(r *rand.Rand) int64 {
+	return r.Int63n(1_000)
+}
+
+// GenWithdrawGraceDuration randomizes the withdraw grace duration
+func GenWithdrawGraceDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Int63n(24*7)) * time.Hour
+}
+
+// RandomizedGenState generates a random GenesisState for the merkledrop module
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		creationFee           []sdk.Coin
+		withdrawGracePeriod   int64
+		withdrawGraceDuration time.Duration
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CreationFee, &creationFee, simState.Rand,
+		func(r *rand.Rand) { creationFee = GenCreationFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WithdrawGracePeriod, &withdrawGracePeriod, simState.Rand,
+		func(r *rand.Rand) { withdrawGracePeriod = GenWithdrawGracePeriod(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, WithdrawGraceDuration, &withdrawGraceDuration, simState.Rand,
+		func(r *rand.Rand) { withdrawGraceDuration = GenWithdrawGraceDuration(r) },
+	)
+
+	merkledropGenesis := types.NewGenesisState(
+		0, []types.Merkledrop{}, []*types.Indexes{},
+		types.NewParams(creationFee, withdrawGracePeriod, withdrawGraceDuration),
+	)
+
+	bz, err := json.MarshalIndent(&merkledropGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated merkledrop parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&merkledropGenesis)
+}
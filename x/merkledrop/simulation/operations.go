@@ -0,0 +1,240 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/client/cli"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/keeper"
+	"github.com/bitsongofficial/go-bitsong/x/merkledrop/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreate = "op_weight_msg_create"
+
+	DefaultWeightMsgCreate = 20
+
+	// maxLeaves is the maximum number of accounts of a simulated merkledrop
+	maxLeaves = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// The claims are not weighted, they are scheduled as future operations by the create operation.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgCreate int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreate, &weightMsgCreate, nil,
+		func(_ *rand.Rand) { weightMsgCreate = DefaultWeightMsgCreate },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreate, SimulateMsgCreate(k, ak, bk)),
+	}
+}
+
+// SimulateMsgCreate simulates the creation of a merkledrop, building the merkle tree of
+// random sim accounts, and schedules the claim of each leaf within the merkledrop window
+func SimulateMsgCreate(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		creationFee := randomFee(r, k.GetParamSet(ctx).CreationFee)
+		spendable, hasNeg := bk.SpendableCoins(ctx, owner.Address).SafeSub(feeCoins(creationFee))
+		if hasNeg || spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, "insufficient funds"), nil, nil
+		}
+
+		// distribute a random part of a random balance among the leaves
+		balance := spendable[r.Intn(len(spendable))]
+		leaves := randomLeaves(r, accs)
+		budget := balance.Amount.QuoRaw(int64(2 * len(leaves)))
+		if !budget.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, "insufficient funds"), nil, nil
+		}
+
+		accMap := make(map[string]string, len(leaves))
+		for _, leaf := range leaves {
+			amount, err := simtypes.RandPositiveInt(r, budget)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, err.Error()), nil, err
+			}
+			accMap[leaf.Address.String()] = amount.String()
+		}
+
+		startHeight := ctx.BlockHeight() + int64(r.Intn(10))
+		endHeight := startHeight + int64(simtypes.RandIntBetween(r, 10, 100))
+
+		// the single denom merkledrops use the leaf version 1
+		var (
+			msg       *types.MsgCreate
+			claimInfo map[string]cli.ClaimInfo
+			coins     sdk.Coins
+		)
+		if r.Intn(2) == 0 {
+			accounts, err := cli.AccountsFromMap(accMap)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, err.Error()), nil, err
+			}
+
+			var (
+				tree   cli.Tree
+				amount sdk.Int
+			)
+			tree, claimInfo, amount, err = cli.CreateDistributionList(accounts)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, err.Error()), nil, err
+			}
+
+			coins = sdk.NewCoins(sdk.NewCoin(balance.Denom, amount))
+			msg = types.NewMsgCreate(owner.Address, fmt.Sprintf("%x", tree.Root()), startHeight, endHeight, coins[0])
+		} else {
+			for addr, amount := range accMap {
+				accMap[addr] = amount + balance.Denom
+			}
+
+			accounts, err := cli.AccountsFromCoinsMap(accMap)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, err.Error()), nil, err
+			}
+
+			var tree cli.Tree
+			tree, claimInfo, coins, err = cli.CreateCoinsDistributionList(accounts)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreate, err.Error()), nil, err
+			}
+
+			msg = types.NewMsgCreateWithCoins(owner.Address, fmt.Sprintf("%x", tree.Root()), startHeight, endHeight, coins)
+		}
+		msg.FeeDenom = creationFee.Denom
+
+		id := k.GetLastMerkleDropId(ctx) + 1
+
+		opMsg, _, err := deliver(r, app, ctx, ak, bk, owner, msg, coins.Add(feeCoins(creationFee)...))
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		// schedule the claims, in the order of the leaves to be deterministic
+		var futureOps []simtypes.FutureOperation
+		for _, leaf := range leaves {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(startHeight) + r.Intn(int(endHeight-startHeight)),
+				Op:          SimulateMsgClaim(k, ak, bk, id, leaf, claimInfo[leaf.Address.String()]),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgClaim simulates the claim of a merkledrop leaf, sent by the leaf account or
+// by a random account on its behalf
+func SimulateMsgClaim(
+	k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, id uint64, beneficiary simtypes.Account, info cli.ClaimInfo,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		res, err := k.Merkledrop(sdk.WrapSDKContext(ctx), &types.QueryMerkledropRequest{Id: id})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaim, "merkledrop does not exist"), nil, nil
+		}
+
+		merkledrop := res.Merkledrop
+		if !merkledrop.HasBegun(ctx.BlockHeight(), ctx.BlockTime()) || merkledrop.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaim, "merkledrop not active"), nil, nil
+		}
+
+		if k.IsClaimed(ctx, id, info.Index) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaim, "already claimed"), nil, nil
+		}
+
+		// claim on behalf of the beneficiary with a random sender
+		sender := beneficiary
+		if r.Intn(2) == 0 {
+			sender, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		var msg *types.MsgClaim
+		if info.Coins != "" {
+			coins, err := sdk.ParseCoinsNormalized(info.Coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaim, err.Error()), nil, err
+			}
+			msg = types.NewMsgClaimWithCoins(info.Index, id, coins, info.Proof, sender.Address)
+		} else {
+			amount, ok := sdk.NewIntFromString(info.Amount)
+			if !ok {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaim, "invalid amount"), nil, fmt.Errorf("invalid amount %s", info.Amount)
+			}
+			msg = types.NewMsgClaim(info.Index, id, amount, info.Proof, sender.Address)
+		}
+		msg.Beneficiary = beneficiary.Address.String()
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, nil)
+	}
+}
+
+// randomLeaves returns between 1 and maxLeaves distinct random sim accounts
+func randomLeaves(r *rand.Rand, accs []simtypes.Account) []simtypes.Account {
+	n := simtypes.RandIntBetween(r, 1, maxLeaves+1)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	var leaves []simtypes.Account
+	for _, i := range r.Perm(len(accs))[:n] {
+		leaves = append(leaves, accs[i])
+	}
+	return leaves
+}
+
+// randomFee picks one of the accepted fees, the zero coin when there is none
+func randomFee(r *rand.Rand, fees []sdk.Coin) sdk.Coin {
+	if len(fees) == 0 {
+		return sdk.Coin{}
+	}
+	return fees[r.Intn(len(fees))]
+}
+
+func feeCoins(fee sdk.Coin) sdk.Coins {
+	if fee.IsNil() || fee.IsZero() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(fee)
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		CoinsSpentInMsg: coinsSpent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
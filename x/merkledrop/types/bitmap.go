@@ -0,0 +1,40 @@
+package types
+
+// ClaimedBitmapWordSize is the number of indexes packed into a claimed bitmap word
+const ClaimedBitmapWordSize = 64
+
+// ClaimedBitmapWordPosition returns the word and the bit of a claimed index
+func ClaimedBitmapWordPosition(index uint64) (word uint64, bit uint64) {
+	return index / ClaimedBitmapWordSize, index % ClaimedBitmapWordSize
+}
+
+// NewBitmap returns the bitmap of the given indexes, relative to the offset.
+// The index offset + i is set if the bit i % 8 of the byte i / 8 is set
+func NewBitmap(offset uint64, indexes []uint64) []byte {
+	var bitmap []byte
+	for _, index := range indexes {
+		bitmap = SetBit(bitmap, index-offset)
+	}
+	return bitmap
+}
+
+// SetBit sets the bit i of the bitmap, growing it if needed
+func SetBit(bitmap []byte, i uint64) []byte {
+	pos := i / 8
+	if uint64(len(bitmap)) <= pos {
+		bitmap = append(bitmap, make([]byte, pos-uint64(len(bitmap))+1)...)
+	}
+
+	bitmap[pos] |= 1 << (i % 8)
+	return bitmap
+}
+
+// IsBitSet returns true if the bit i of the bitmap is set
+func IsBitSet(bitmap []byte, i uint64) bool {
+	pos := i / 8
+	if uint64(len(bitmap)) <= pos {
+		return false
+	}
+
+	return bitmap[pos]&(1<<(i%8)) != 0
+}
@@ -23,18 +23,28 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreate{}, "go-bitsong/merkledrop/MsgCreate", nil)
 	cdc.RegisterConcrete(&MsgClaim{}, "go-bitsong/merkledrop/MsgClaim", nil)
+	cdc.RegisterConcrete(&MsgWithdraw{}, "go-bitsong/merkledrop/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgFund{}, "go-bitsong/merkledrop/MsgFund", nil)
+	cdc.RegisterConcrete(&MsgExtend{}, "go-bitsong/merkledrop/MsgExtend", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "go-bitsong/merkledrop/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&UpdateFeesProposal{}, "go-bitsong/merkledrop/UpdateFeesProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "go-bitsong/merkledrop/UpdateParamsProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreate{},
 		&MsgClaim{},
+		&MsgWithdraw{},
+		&MsgFund{},
+		&MsgExtend{},
+		&MsgUpdateParams{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdateFeesProposal{},
+		&UpdateParamsProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
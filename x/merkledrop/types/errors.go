@@ -19,4 +19,11 @@ var (
 	ErrAlreadyWithdrawn     = sdkerrors.Register(ModuleName, 14, "funds have been already withdrawn")
 	ErrCreationFee          = sdkerrors.Register(ModuleName, 15, "cannot deduct creation fee")
 	ErrDeleteMerkledrop     = sdkerrors.Register(ModuleName, 16, "failed delete merkledrop")
+	ErrInvalidLeafVersion   = sdkerrors.Register(ModuleName, 17, "invalid leaf version")
+	ErrWithdrawNotAllowed   = sdkerrors.Register(ModuleName, 18, "withdraw not allowed")
+	ErrInvalidStartTime     = sdkerrors.Register(ModuleName, 19, "invalid start time")
+	ErrInvalidEndTime       = sdkerrors.Register(ModuleName, 20, "invalid end time")
+	ErrInvalidBeneficiary   = sdkerrors.Register(ModuleName, 21, "invalid beneficiary")
+	ErrInvalidRecipient     = sdkerrors.Register(ModuleName, 22, "invalid recipient")
+	ErrInvalidSignature     = sdkerrors.Register(ModuleName, 23, "invalid recipient signature")
 )
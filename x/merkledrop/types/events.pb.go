@@ -5,19 +5,23 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,9 +68,11 @@ func (m *EventCreate) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventCreate proto.InternalMessageInfo
 
 type EventClaim struct {
-	MerkledropId uint64                                  `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
-	Index        uint64                                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Coin         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=coin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	MerkledropId uint64                                   `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
+	Index        uint64                                   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Coins        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Beneficiary  string                                   `protobuf:"bytes,5,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Recipient    string                                   `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *EventClaim) Reset()         { *m = EventClaim{} }
@@ -103,8 +109,8 @@ func (m *EventClaim) XXX_DiscardUnknown() {
 var xxx_messageInfo_EventClaim proto.InternalMessageInfo
 
 type EventWithdraw struct {
-	MerkledropId uint64                                  `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
-	Coin         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin"`
+	MerkledropId uint64                                   `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
+	Coins        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
 func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
@@ -140,10 +146,89 @@ func (m *EventWithdraw) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventWithdraw proto.InternalMessageInfo
 
+type EventFund struct {
+	MerkledropId uint64                                   `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
+	Coins        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EventFund) Reset()         { *m = EventFund{} }
+func (m *EventFund) String() string { return proto.CompactTextString(m) }
+func (*EventFund) ProtoMessage()    {}
+func (*EventFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3042ab6a9db80a59, []int{3}
+}
+func (m *EventFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFund.Merge(m, src)
+}
+func (m *EventFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFund proto.InternalMessageInfo
+
+type EventExtend struct {
+	MerkledropId uint64     `protobuf:"varint,1,opt,name=merkledrop_id,json=merkledropId,proto3" json:"merkledrop_id,omitempty"`
+	EndHeight    int64      `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	EndTime      *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *EventExtend) Reset()         { *m = EventExtend{} }
+func (m *EventExtend) String() string { return proto.CompactTextString(m) }
+func (*EventExtend) ProtoMessage()    {}
+func (*EventExtend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3042ab6a9db80a59, []int{4}
+}
+func (m *EventExtend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExtend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExtend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExtend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExtend.Merge(m, src)
+}
+func (m *EventExtend) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExtend) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExtend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExtend proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventCreate)(nil), "bitsong.merkledrop.v1beta1.EventCreate")
 	proto.RegisterType((*EventClaim)(nil), "bitsong.merkledrop.v1beta1.EventClaim")
 	proto.RegisterType((*EventWithdraw)(nil), "bitsong.merkledrop.v1beta1.EventWithdraw")
+	proto.RegisterType((*EventFund)(nil), "bitsong.merkledrop.v1beta1.EventFund")
+	proto.RegisterType((*EventExtend)(nil), "bitsong.merkledrop.v1beta1.EventExtend")
 }
 
 func init() {
@@ -151,29 +236,38 @@ func init() {
 }
 
 var fileDescriptor_3042ab6a9db80a59 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x3d, 0x4f, 0x3a, 0x31,
-	0x18, 0xbf, 0xf2, 0xe7, 0x6f, 0x62, 0x91, 0xe5, 0xc2, 0x80, 0x0c, 0x85, 0xe0, 0x00, 0x0b, 0x6d,
-	0xd0, 0xc5, 0x19, 0x62, 0xa2, 0x2b, 0x83, 0x26, 0x0e, 0x9a, 0x7b, 0x29, 0x47, 0x03, 0x77, 0x0f,
-	0x69, 0x2b, 0xe0, 0xb7, 0x70, 0xf0, 0x3b, 0xf8, 0x55, 0x18, 0x19, 0x8d, 0x03, 0x51, 0xf8, 0x22,
-	0xe6, 0xda, 0xc3, 0x23, 0x61, 0x71, 0x71, 0xba, 0x7b, 0xda, 0xdf, 0x6b, 0xfa, 0xe0, 0x96, 0x2f,
-	0xb4, 0x82, 0x24, 0x62, 0x31, 0x97, 0xe3, 0x09, 0x0f, 0x25, 0x4c, 0xd9, 0xac, 0xeb, 0x73, 0xed,
-	0x75, 0x19, 0x9f, 0xf1, 0x44, 0x2b, 0x3a, 0x95, 0xa0, 0xc1, 0xad, 0x65, 0x40, 0x9a, 0x03, 0x69,
-	0x06, 0xac, 0x55, 0x22, 0x88, 0xc0, 0xc0, 0x58, 0xfa, 0x67, 0x19, 0x35, 0x12, 0x80, 0x8a, 0x41,
-	0x31, 0xdf, 0x53, 0xfc, 0x47, 0x33, 0x00, 0x91, 0xd8, 0xfb, 0xe6, 0x35, 0x2e, 0x5d, 0xa5, 0x0e,
-	0x7d, 0xc9, 0x3d, 0xcd, 0xdd, 0x0a, 0xfe, 0x0f, 0xf3, 0x84, 0xcb, 0x2a, 0x6a, 0xa0, 0xf6, 0xf1,
-	0xc0, 0x0e, 0xee, 0x19, 0x2e, 0xe7, 0x86, 0x8f, 0x22, 0xac, 0x16, 0x1a, 0xa8, 0x5d, 0x1c, 0x9c,
-	0xe4, 0x87, 0x37, 0x61, 0xf3, 0x0d, 0x61, 0x6c, 0xa5, 0x26, 0x9e, 0x88, 0x0f, 0x39, 0xe8, 0x90,
-	0x93, 0xda, 0x89, 0x24, 0xe4, 0x8b, 0x4c, 0xd0, 0x0e, 0xee, 0x03, 0x2e, 0xa6, 0x09, 0xab, 0xff,
-	0x1a, 0xa8, 0x5d, 0x3a, 0x3f, 0xa5, 0xb6, 0x02, 0x4d, 0x2b, 0xec, 0xda, 0xd2, 0x3e, 0x88, 0xa4,
-	0xc7, 0x96, 0xeb, 0xba, 0xf3, 0xb1, 0xae, 0xb7, 0x22, 0xa1, 0x47, 0x4f, 0x3e, 0x0d, 0x20, 0x66,
-	0x59, 0x5f, 0xfb, 0xe9, 0xa8, 0x70, 0xcc, 0xf4, 0xf3, 0x94, 0x2b, 0x43, 0x18, 0x18, 0xdd, 0xe6,
-	0x2b, 0xc2, 0x65, 0x93, 0xf4, 0x4e, 0xe8, 0x51, 0x28, 0xbd, 0xf9, 0xef, 0xc2, 0xee, 0x62, 0x15,
-	0xfe, 0x26, 0x56, 0xef, 0x76, 0xf9, 0x45, 0x9c, 0xe5, 0x86, 0xa0, 0xd5, 0x86, 0xa0, 0xcf, 0x0d,
-	0x41, 0x2f, 0x5b, 0xe2, 0xac, 0xb6, 0xc4, 0x79, 0xdf, 0x12, 0xe7, 0xfe, 0x72, 0x4f, 0x2c, 0xdb,
-	0x02, 0x18, 0x0e, 0x45, 0x20, 0xbc, 0x09, 0x8b, 0xa0, 0xb3, 0xdb, 0xa0, 0xc5, 0xfe, 0x0e, 0x19,
-	0x0b, 0xff, 0xc8, 0xbc, 0xf4, 0xc5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0xcc, 0x06, 0x92,
-	0x66, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xd6, 0x4e, 0x49, 0x36, 0x54, 0xaa, 0xac, 0x1e, 0x4c, 0x04, 0x8e, 0x65, 0x0e, 0xf8,
+	0xd2, 0x5d, 0x5a, 0x2e, 0x48, 0xdc, 0x52, 0x15, 0x95, 0x1e, 0x2d, 0x04, 0x12, 0x97, 0xca, 0xf6,
+	0x4e, 0x9c, 0x55, 0xe3, 0x5d, 0xcb, 0xbb, 0x69, 0xd3, 0x9f, 0x40, 0x95, 0x38, 0xf3, 0x03, 0x7c,
+	0x49, 0x8e, 0x3d, 0x72, 0xa2, 0x90, 0xfc, 0x00, 0x9f, 0x80, 0xbc, 0x76, 0x9a, 0x48, 0xbd, 0x94,
+	0x43, 0x4f, 0xf6, 0xcc, 0xbc, 0x99, 0x79, 0x6f, 0x35, 0x0f, 0xbf, 0x4a, 0xb8, 0x56, 0x52, 0x64,
+	0x34, 0x87, 0xf2, 0x7c, 0x02, 0xac, 0x94, 0x05, 0xbd, 0x38, 0x48, 0x40, 0xc7, 0x07, 0x14, 0x2e,
+	0x40, 0x68, 0x45, 0x8a, 0x52, 0x6a, 0xe9, 0xf4, 0x1b, 0x20, 0x59, 0x03, 0x49, 0x03, 0xec, 0xef,
+	0x65, 0x32, 0x93, 0x06, 0x46, 0xab, 0xbf, 0xba, 0xa3, 0x3f, 0xc8, 0xa4, 0xcc, 0x26, 0x40, 0x4d,
+	0x94, 0x4c, 0x47, 0x54, 0xf3, 0x1c, 0x94, 0x8e, 0xf3, 0xa2, 0x01, 0x78, 0xa9, 0x54, 0xb9, 0x54,
+	0x34, 0x89, 0x15, 0xdc, 0x2d, 0x4d, 0x25, 0x17, 0x75, 0x3d, 0x38, 0xc1, 0xbd, 0xe3, 0x8a, 0xc2,
+	0x51, 0x09, 0xb1, 0x06, 0x67, 0x0f, 0xb7, 0xe5, 0xa5, 0x80, 0xd2, 0x45, 0x3e, 0x0a, 0xbb, 0x51,
+	0x1d, 0x38, 0x2f, 0xf1, 0xce, 0x9a, 0xd1, 0x19, 0x67, 0xee, 0x96, 0x8f, 0x42, 0x3b, 0x7a, 0xba,
+	0x4e, 0x7e, 0x60, 0xc1, 0x5f, 0x84, 0x71, 0x3d, 0x6a, 0x12, 0xf3, 0xfc, 0x7e, 0x0f, 0xba, 0xdf,
+	0x53, 0xad, 0xe3, 0x82, 0xc1, 0xac, 0x19, 0x58, 0x07, 0x4e, 0x8c, 0xdb, 0x15, 0x43, 0xe5, 0xda,
+	0xbe, 0x15, 0xf6, 0x0e, 0x9f, 0x91, 0x5a, 0x03, 0xa9, 0x34, 0xac, 0xde, 0x83, 0x1c, 0x49, 0x2e,
+	0x86, 0xaf, 0xe7, 0xbf, 0x06, 0xad, 0x1f, 0xb7, 0x83, 0x30, 0xe3, 0x7a, 0x3c, 0x4d, 0x48, 0x2a,
+	0x73, 0xda, 0x08, 0xae, 0x3f, 0xfb, 0x8a, 0x9d, 0x53, 0x7d, 0x55, 0x80, 0x32, 0x0d, 0x2a, 0xaa,
+	0x27, 0x3b, 0x3e, 0xee, 0x25, 0x20, 0x60, 0xc4, 0x53, 0x1e, 0x97, 0x57, 0x6e, 0xdb, 0xa8, 0xdd,
+	0x4c, 0x39, 0xcf, 0x71, 0xb7, 0x84, 0x94, 0x17, 0x1c, 0x84, 0x76, 0xb7, 0x4d, 0x7d, 0x9d, 0x38,
+	0xb5, 0x3b, 0xd6, 0xae, 0x1d, 0x7c, 0x47, 0x78, 0xc7, 0x48, 0xfe, 0xcc, 0xf5, 0x98, 0x95, 0xf1,
+	0xe5, 0xc3, 0x54, 0xdf, 0xe9, 0xb3, 0x1e, 0x4b, 0xdf, 0xa9, 0xdd, 0xd9, 0xda, 0xb5, 0x82, 0x6f,
+	0x08, 0x77, 0x0d, 0xbf, 0xf7, 0x53, 0xc1, 0xfe, 0x93, 0xdb, 0xd6, 0x63, 0x71, 0x0b, 0xbe, 0xa2,
+	0xe6, 0xe6, 0x8e, 0x67, 0x1a, 0x1e, 0xca, 0xeb, 0x05, 0xc6, 0x20, 0xd8, 0xd9, 0x18, 0x78, 0x36,
+	0xd6, 0xe6, 0x5c, 0xac, 0xa8, 0x0b, 0x82, 0x9d, 0x98, 0x84, 0xf3, 0x0e, 0x77, 0xaa, 0x72, 0x75,
+	0xfd, 0xae, 0xe5, 0xa3, 0xb0, 0x77, 0xd8, 0x27, 0xb5, 0x35, 0xc8, 0xca, 0x1a, 0xe4, 0xe3, 0xca,
+	0x1a, 0x43, 0xfb, 0xfa, 0x76, 0x80, 0xa2, 0x27, 0x20, 0x58, 0x95, 0x1b, 0x7e, 0x9a, 0xff, 0xf1,
+	0x5a, 0xf3, 0x85, 0x87, 0x6e, 0x16, 0x1e, 0xfa, 0xbd, 0xf0, 0xd0, 0xf5, 0xd2, 0x6b, 0xdd, 0x2c,
+	0xbd, 0xd6, 0xcf, 0xa5, 0xd7, 0xfa, 0xf2, 0x76, 0x43, 0x5f, 0xe3, 0x4f, 0x39, 0x32, 0x57, 0x32,
+	0xa1, 0x99, 0xdc, 0x5f, 0x79, 0x7b, 0xb6, 0xe9, 0x6e, 0xa3, 0x3a, 0xd9, 0x36, 0xab, 0xdf, 0xfc,
+	0x1b, 0x00, 0xb8, 0x0a, 0x45, 0x35, 0x00, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreate) Marshal() (dAtA []byte, err error) {
@@ -231,16 +325,34 @@ func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Coin.Size()
-		i -= size
-		if _, err := m.Coin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.Index != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
 		i--
@@ -274,16 +386,105 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Coin.Size()
-		i -= size
-		if _, err := m.Coin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MerkledropId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MerkledropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MerkledropId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MerkledropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExtend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExtend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExtend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i -= n1
+		i = encodeVarintEvents(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.MerkledropId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MerkledropId))
 		i--
@@ -331,8 +532,20 @@ func (m *EventClaim) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovEvents(uint64(m.Index))
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -345,8 +558,49 @@ func (m *EventWithdraw) Size() (n int) {
 	if m.MerkledropId != 0 {
 		n += 1 + sovEvents(uint64(m.MerkledropId))
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerkledropId != 0 {
+		n += 1 + sovEvents(uint64(m.MerkledropId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExtend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MerkledropId != 0 {
+		n += 1 + sovEvents(uint64(m.MerkledropId))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -524,9 +778,9 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -553,10 +807,75 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -607,6 +926,109 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkledropId", wireType)
+			}
+			m.MerkledropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerkledropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MerkledropId", wireType)
@@ -628,7 +1050,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -655,7 +1077,132 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExtend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExtend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExtend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkledropId", wireType)
+			}
+			m.MerkledropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MerkledropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -10,6 +11,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
@@ -17,14 +19,18 @@ type BankKeeper interface {
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramstypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramstypes.ParamSet)
+	Set(ctx sdk.Context, key []byte, value interface{})
+	GetRaw(ctx sdk.Context, key []byte) []byte
 	HasKeyTable() bool
 	WithKeyTable(table paramstypes.KeyTable) paramstypes.Subspace
 }
 
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
-type DistrKeeper interface {
-	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+// FeesKeeper defines the expected fees keeper distributing the module fees
+type FeesKeeper interface {
+	DistributeFees(ctx sdk.Context, module, action string, payer sdk.AccAddress, fees sdk.Coins) error
 }
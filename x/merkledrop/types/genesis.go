@@ -18,6 +18,14 @@ func ValidateGenesis(data GenesisState) error {
 		if md.Id > data.LastMerkledropId {
 			return fmt.Errorf("invalid merlkedrop id: %d", md.Id)
 		}
+
+		if err := md.ValidateCoins(); err != nil {
+			return fmt.Errorf("invalid merkledrop %d: %w", md.Id, err)
+		}
+
+		if err := md.ValidateWindow(); err != nil {
+			return fmt.Errorf("invalid merkledrop %d: %w", md.Id, err)
+		}
 	}
 
 	for _, i := range data.Indexes {
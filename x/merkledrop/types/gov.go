@@ -2,21 +2,33 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"strings"
+
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 )
 
-const ProposalTypeUpdateFees = "UpdateMerkledropFeesProposal"
+const (
+	ProposalTypeUpdateFees   = "UpdateMerkledropFeesProposal"
+	ProposalTypeUpdateParams = "UpdateMerkledropParamsProposal"
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdateFees)
 	govtypes.RegisterProposalTypeCodec(&UpdateFeesProposal{}, "go-bitsong/merkledrop/UpdateFeesProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "go-bitsong/merkledrop/UpdateParamsProposal")
 }
 
-var _ govtypes.Content = &UpdateFeesProposal{}
+var (
+	_ govtypes.Content = &UpdateFeesProposal{}
+	_ govtypes.Content = &UpdateParamsProposal{}
+)
 
-func NewUpdateFeesProposal(title, description string, creationFee sdk.Coin) govtypes.Content {
+// NewUpdateFeesProposal creates a legacy fees proposal.
+func NewUpdateFeesProposal(title, description string, creationFee []sdk.Coin) govtypes.Content {
 	return &UpdateFeesProposal{
 		Title:       title,
 		Description: description,
@@ -38,7 +50,7 @@ func (p *UpdateFeesProposal) ValidateBasic() error {
 		return err
 	}
 
-	if err := p.CreationFee.Validate(); err != nil {
+	if err := feestypes.ValidateAcceptedFees(p.CreationFee); err != nil {
 		return err
 	}
 
@@ -51,6 +63,43 @@ func (p UpdateFeesProposal) String() string {
   Title:       %s
   Description: %s
   Creation Fee:   %s
-`, p.Title, p.Description, p.CreationFee))
+`, p.Title, p.Description, feestypes.FeesString(p.CreationFee)))
+	return b.String()
+}
+
+// NewUpdateParamsProposal creates a legacy proposal replacing all the params
+func NewUpdateParamsProposal(title, description string, params Params) govtypes.Content {
+	return &UpdateParamsProposal{
+		Title:       title,
+		Description: description,
+		Params:      params,
+	}
+}
+
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return p.Params.Validate()
+}
+
+func (p UpdateParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Merkledrop Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params.String()))
 	return b.String()
 }
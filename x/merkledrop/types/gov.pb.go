@@ -24,10 +24,11 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpdateFeesProposal updates the fees from a legacy governance proposal.
 type UpdateFeesProposal struct {
-	Title       string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CreationFee types.Coin `protobuf:"bytes,3,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee" yaml:"creation_fee"`
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreationFee []types.Coin `protobuf:"bytes,3,rep,name=creation_fee,json=creationFee,proto3" json:"creation_fee" yaml:"creation_fee"`
 }
 
 func (m *UpdateFeesProposal) Reset()      { *m = UpdateFeesProposal{} }
@@ -102,9 +103,91 @@ func (m *UpdateFeesProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateFeesProposalWithDeposit proto.InternalMessageInfo
 
+// UpdateParamsProposal replaces all the params at once from a legacy governance
+// proposal, executing the MsgUpdateParams with the gov module as authority
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12c02fad8b811074, []int{2}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+type UpdateParamsProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *UpdateParamsProposalWithDeposit) Reset()         { *m = UpdateParamsProposalWithDeposit{} }
+func (m *UpdateParamsProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsProposalWithDeposit) ProtoMessage()    {}
+func (*UpdateParamsProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12c02fad8b811074, []int{3}
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposalWithDeposit.Merge(m, src)
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposalWithDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UpdateFeesProposal)(nil), "bitsong.merkledrop.v1beta1.UpdateFeesProposal")
 	proto.RegisterType((*UpdateFeesProposalWithDeposit)(nil), "bitsong.merkledrop.v1beta1.UpdateFeesProposalWithDeposit")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "bitsong.merkledrop.v1beta1.UpdateParamsProposal")
+	proto.RegisterType((*UpdateParamsProposalWithDeposit)(nil), "bitsong.merkledrop.v1beta1.UpdateParamsProposalWithDeposit")
 }
 
 func init() {
@@ -112,30 +195,34 @@ func init() {
 }
 
 var fileDescriptor_12c02fad8b811074 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x4e, 0xc2, 0x50,
-	0x14, 0xc6, 0x7b, 0xfd, 0x87, 0x14, 0xa7, 0xca, 0x50, 0x31, 0xde, 0x62, 0xe3, 0xc0, 0x62, 0x1b,
-	0x74, 0x31, 0x8c, 0x68, 0x98, 0x0d, 0x89, 0x1a, 0x5d, 0x4c, 0xff, 0x1c, 0xca, 0x8d, 0x6d, 0xcf,
-	0x4d, 0xef, 0x95, 0xc8, 0x5b, 0x38, 0x12, 0x27, 0x5e, 0xc1, 0xb7, 0x60, 0x64, 0x74, 0x22, 0x0a,
-	0x8b, 0xb3, 0x4f, 0x60, 0x68, 0xa9, 0x62, 0x74, 0x72, 0xfb, 0xbe, 0x7b, 0xbe, 0x2f, 0xf7, 0x97,
-	0x9c, 0xa3, 0x1e, 0xb8, 0x4c, 0x0a, 0x8c, 0x03, 0x3b, 0x82, 0xe4, 0x2e, 0x04, 0x3f, 0x41, 0x6e,
-	0xf7, 0xea, 0x2e, 0x48, 0xa7, 0x6e, 0x07, 0xd8, 0xb3, 0x78, 0x82, 0x12, 0xb5, 0xca, 0x22, 0x65,
-	0x7d, 0xa7, 0xac, 0x45, 0xaa, 0x42, 0x3d, 0x14, 0x11, 0x0a, 0xdb, 0x75, 0x04, 0x7c, 0x55, 0x3d,
-	0x64, 0x71, 0xd6, 0xad, 0x94, 0x03, 0x0c, 0x30, 0x95, 0xf6, 0x5c, 0x65, 0xaf, 0xe6, 0x33, 0x51,
-	0xb5, 0x0b, 0xee, 0x3b, 0x12, 0x5a, 0x00, 0xe2, 0x3c, 0x41, 0x8e, 0xc2, 0x09, 0xb5, 0xb2, 0xba,
-	0x2e, 0x99, 0x0c, 0x41, 0x27, 0x55, 0x52, 0x2b, 0xb6, 0x33, 0xa3, 0x55, 0xd5, 0x92, 0x0f, 0xc2,
-	0x4b, 0x18, 0x97, 0x0c, 0x63, 0x7d, 0x25, 0x9d, 0x2d, 0x3f, 0x69, 0xd7, 0xea, 0x96, 0x97, 0x80,
-	0x33, 0xd7, 0xb7, 0x1d, 0x00, 0x7d, 0xb5, 0x4a, 0x6a, 0xa5, 0xa3, 0x1d, 0x2b, 0x63, 0xb3, 0xe6,
-	0x6c, 0x39, 0xb0, 0x75, 0x8a, 0x2c, 0x6e, 0xee, 0x8e, 0x26, 0x86, 0xf2, 0x31, 0x31, 0xb6, 0xfb,
-	0x4e, 0x14, 0x36, 0xcc, 0xe5, 0xb2, 0xd9, 0x2e, 0xe5, 0xb6, 0x05, 0xd0, 0xd8, 0x1c, 0x0c, 0x0d,
-	0xe5, 0x7d, 0x68, 0x10, 0xf3, 0x89, 0xa8, 0x7b, 0xbf, 0x99, 0xaf, 0x98, 0xec, 0x9e, 0x01, 0x47,
-	0xc1, 0xe4, 0xbf, 0xf1, 0xf7, 0xff, 0xc0, 0x2f, 0xfe, 0xc0, 0xd0, 0x74, 0xb5, 0xe0, 0x67, 0xbf,
-	0xe8, 0x85, 0x74, 0x9a, 0xdb, 0xc6, 0xda, 0x60, 0x68, 0x90, 0xe6, 0xe5, 0xe8, 0x8d, 0x2a, 0xa3,
-	0x29, 0x25, 0xe3, 0x29, 0x25, 0xaf, 0x53, 0x4a, 0x1e, 0x67, 0x54, 0x19, 0xcf, 0xa8, 0xf2, 0x32,
-	0xa3, 0xca, 0xcd, 0x49, 0xc0, 0x64, 0xf7, 0xde, 0xb5, 0x3c, 0x8c, 0xec, 0xc5, 0x2e, 0xb1, 0xd3,
-	0x61, 0x1e, 0x73, 0x42, 0x3b, 0xc0, 0xc3, 0xfc, 0x08, 0x1e, 0x96, 0xcf, 0x40, 0xf6, 0x39, 0x08,
-	0x77, 0x23, 0xdd, 0xd7, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xb6, 0x35, 0x98, 0x29,
-	0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0xd4, 0x40,
+	0x10, 0x86, 0xbd, 0xe4, 0x48, 0x60, 0x4d, 0x65, 0xae, 0x30, 0x87, 0x58, 0x1f, 0x16, 0x12, 0x69,
+	0x58, 0x2b, 0xa1, 0x41, 0x57, 0xa1, 0x03, 0xa5, 0x8e, 0x4e, 0x02, 0x04, 0x0d, 0x5a, 0xdb, 0x73,
+	0xce, 0x0a, 0xdb, 0xb3, 0xf2, 0x2e, 0x11, 0x79, 0x0b, 0xca, 0x88, 0x2a, 0xaf, 0x40, 0xcd, 0x0b,
+	0x5c, 0x99, 0x92, 0x2a, 0x82, 0xbb, 0x86, 0x9a, 0x27, 0x40, 0xf6, 0xda, 0x60, 0x29, 0x07, 0x05,
+	0x97, 0x6e, 0x66, 0xe7, 0x1f, 0xcd, 0x37, 0xbf, 0x3d, 0xf4, 0x41, 0x2c, 0x8d, 0xc6, 0x32, 0x8b,
+	0x0a, 0xa8, 0xde, 0xe5, 0x90, 0x56, 0xa8, 0xa2, 0xe3, 0xbd, 0x18, 0x8c, 0xd8, 0x8b, 0x32, 0x3c,
+	0xe6, 0xaa, 0x42, 0x83, 0xde, 0xa8, 0x55, 0xf1, 0x3f, 0x2a, 0xde, 0xaa, 0x46, 0x2c, 0x41, 0x5d,
+	0xa0, 0x8e, 0x62, 0xa1, 0xe1, 0x77, 0x6b, 0x82, 0xb2, 0xb4, 0xbd, 0xa3, 0x61, 0x86, 0x19, 0x36,
+	0x61, 0x54, 0x47, 0xed, 0xeb, 0xc3, 0x7f, 0xcc, 0x55, 0xa2, 0x12, 0x85, 0xb6, 0xc2, 0xf0, 0x33,
+	0xa1, 0xde, 0x0b, 0x95, 0x0a, 0x03, 0x07, 0x00, 0xfa, 0xb0, 0x42, 0x85, 0x5a, 0xe4, 0xde, 0x90,
+	0x5e, 0x37, 0xd2, 0xe4, 0xe0, 0x93, 0x31, 0xd9, 0xbd, 0x39, 0xb3, 0x89, 0x37, 0xa6, 0x6e, 0x0a,
+	0x3a, 0xa9, 0xa4, 0x32, 0x12, 0x4b, 0xff, 0x5a, 0x53, 0xeb, 0x3f, 0x79, 0xaf, 0xe9, 0xad, 0xa4,
+	0x02, 0x51, 0xc7, 0x6f, 0xe7, 0x00, 0xfe, 0xd6, 0x78, 0x6b, 0xd7, 0xdd, 0xbf, 0xc3, 0xed, 0x12,
+	0xbc, 0x5e, 0xa2, 0xdb, 0x8c, 0x3f, 0x43, 0x59, 0x4e, 0xef, 0x2e, 0x2e, 0x02, 0xe7, 0xe7, 0x45,
+	0x70, 0xfb, 0x44, 0x14, 0xf9, 0x24, 0xec, 0x37, 0x87, 0x33, 0xb7, 0x4b, 0x0f, 0x00, 0x26, 0x37,
+	0x4e, 0xcf, 0x02, 0xe7, 0xc7, 0x59, 0x40, 0xc2, 0x4f, 0x84, 0xde, 0xbb, 0xcc, 0xfc, 0x4a, 0x9a,
+	0xa3, 0xe7, 0xa0, 0x50, 0x4b, 0xf3, 0xdf, 0xf8, 0xf7, 0x2f, 0xe1, 0x37, 0x92, 0x1e, 0x86, 0xe7,
+	0xd3, 0x9d, 0xd4, 0x4e, 0xf1, 0x77, 0x9a, 0x6a, 0x97, 0x4e, 0x06, 0xa7, 0x2d, 0xdc, 0xd0, 0xc2,
+	0x1d, 0x36, 0x3e, 0x6f, 0x6c, 0xe9, 0x53, 0xba, 0x6d, 0xbf, 0x58, 0x43, 0xe3, 0xee, 0x87, 0xfc,
+	0xef, 0x7f, 0x0b, 0xb7, 0x33, 0xa7, 0x83, 0xda, 0xd5, 0x59, 0xdb, 0xd7, 0x73, 0xee, 0x0b, 0xa1,
+	0xc1, 0x3a, 0xb8, 0xab, 0xf0, 0x6e, 0x63, 0xce, 0xbe, 0xb5, 0x83, 0x35, 0xd6, 0x4e, 0x5f, 0x2e,
+	0xbe, 0x33, 0x67, 0xb1, 0x64, 0xe4, 0x7c, 0xc9, 0xc8, 0xb7, 0x25, 0x23, 0x1f, 0x57, 0xcc, 0x39,
+	0x5f, 0x31, 0xe7, 0xeb, 0x8a, 0x39, 0x6f, 0x9e, 0x64, 0xd2, 0x1c, 0xbd, 0x8f, 0x79, 0x82, 0x45,
+	0xd4, 0x4e, 0xc6, 0xf9, 0x5c, 0x26, 0x52, 0xe4, 0x51, 0x86, 0x8f, 0xba, 0x83, 0xf8, 0xd0, 0x3f,
+	0x09, 0x73, 0xa2, 0x40, 0xc7, 0xdb, 0xcd, 0x29, 0x3c, 0xfe, 0x35, 0x00, 0x5f, 0xe2, 0xfa, 0x31,
+	0xad, 0x03, 0x00, 0x00,
 }
 
 func (this *UpdateFeesProposal) Equal(that interface{}) bool {
@@ -163,7 +250,42 @@ func (this *UpdateFeesProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if !this.CreationFee.Equal(&that1.CreationFee) {
+	if len(this.CreationFee) != len(that1.CreationFee) {
+		return false
+	}
+	for i := range this.CreationFee {
+		if !this.CreationFee[i].Equal(&that1.CreationFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *UpdateParamsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateParamsProposal)
+	if !ok {
+		that2, ok := that.(UpdateParamsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
 	return true
@@ -188,16 +310,20 @@ func (m *UpdateFeesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CreationFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -266,6 +392,107 @@ func (m *UpdateFeesProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -291,8 +518,12 @@ func (m *UpdateFeesProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	l = m.CreationFee.Size()
-	n += 1 + l + sovGov(uint64(l))
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -321,6 +552,48 @@ func (m *UpdateFeesProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *UpdateParamsProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,7 +722,8 @@ func (m *UpdateFeesProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -652,6 +926,332 @@ func (m *UpdateFeesProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"time"
 )
 
 const (
@@ -23,16 +24,20 @@ const (
 // - 0x01:<merkledropID_bytes>: merkledrop
 // - 0x02:<owner>:<merkledropID_bytes>: merkledrop
 // - 0x03: lastMerkledropID
-// - 0x04:<merkledropID_bytes>:<merkledropIndex>: true
+// - 0x04:<merkledropID_bytes>:<merkledropIndex>: true (legacy, moved to 0x05 by the v3 store migration)
+// - 0x05:<merkledropID_bytes>:<wordIndex_bytes>: bitmap word of the claimed indexes
 // - 0x10:<merkedropEndHeight>: merkledropID
+// - 0x11:<merkedropEndTime>:<merkledropID_bytes>: true
 var (
 	PrefixMerkleDrop        = []byte{0x01}
 	PrefixMerkleDropByOwner = []byte{0x02}
 	KeyLastMerkleDropId     = []byte{0x03}
 
 	PrefixClaimedMerkleDrop = []byte{0x04}
+	PrefixClaimedBitmap     = []byte{0x05}
 
 	PrefixMerkleDropByEndHeight = []byte{0x10}
+	PrefixMerkleDropByEndTime   = []byte{0x11}
 
 	sep = []byte(":")
 )
@@ -48,6 +53,10 @@ func MerkledropOwnerKey(id uint64, owner sdk.AccAddress) []byte {
 	return genKey(PrefixMerkleDropByOwner, sep, owner, sep, idBz)
 }
 
+func MerkledropOwnerPrefix(owner sdk.AccAddress) []byte {
+	return genKey(PrefixMerkleDropByOwner, sep, owner, sep)
+}
+
 func MerkledropEndHeightKey(height int64) []byte {
 	heightBz := sdk.Uint64ToBigEndian(uint64(height))
 	return genKey(PrefixMerkleDropByEndHeight, sep, heightBz, sep)
@@ -59,18 +68,49 @@ func MerkledropEndHeightAndIDKey(height int64, id uint64) []byte {
 	return genKey(PrefixMerkleDropByEndHeight, sep, heightBz, sep, idBz)
 }
 
+func MerkledropEndTimePrefix() []byte {
+	return genKey(PrefixMerkleDropByEndTime, sep)
+}
+
+func MerkledropEndTimeKey(endTime time.Time) []byte {
+	return genKey(PrefixMerkleDropByEndTime, sep, sdk.FormatTimeBytes(endTime), sep)
+}
+
+func MerkledropEndTimeAndIDKey(endTime time.Time, id uint64) []byte {
+	idBz := sdk.Uint64ToBigEndian(id)
+	return genKey(PrefixMerkleDropByEndTime, sep, sdk.FormatTimeBytes(endTime), sep, idBz)
+}
+
 func LastMerkledropIDKey() []byte {
 	return KeyLastMerkleDropId
 }
 
+// ClaimedMerkledropIndexKey returns the legacy key of a claimed index
 func ClaimedMerkledropIndexKey(id, index uint64) []byte {
 	return genKey(PrefixClaimedMerkleDrop, sep, sdk.Uint64ToBigEndian(id), sep, sdk.Uint64ToBigEndian(index))
 }
 
+// ClaimedMerkledropPrefix returns the legacy prefix of all the claimed indexes
+func ClaimedMerkledropPrefix() []byte {
+	return genKey(PrefixClaimedMerkleDrop, sep)
+}
+
+// ClaimedMerkledropKey returns the legacy prefix of the claimed indexes of a merkledrop
 func ClaimedMerkledropKey(id uint64) []byte {
 	return genKey(PrefixClaimedMerkleDrop, sep, sdk.Uint64ToBigEndian(id), sep)
 }
 
+// ClaimedBitmapWordKey returns the key of the bitmap word holding the claimed indexes
+// from word * ClaimedBitmapWordSize to (word + 1) * ClaimedBitmapWordSize - 1
+func ClaimedBitmapWordKey(id, word uint64) []byte {
+	return genKey(PrefixClaimedBitmap, sep, sdk.Uint64ToBigEndian(id), sep, sdk.Uint64ToBigEndian(word))
+}
+
+// ClaimedBitmapKey returns the prefix of the bitmap words of a merkledrop
+func ClaimedBitmapKey(id uint64) []byte {
+	return genKey(PrefixClaimedBitmap, sep, sdk.Uint64ToBigEndian(id), sep)
+}
+
 func genKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
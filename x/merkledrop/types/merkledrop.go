@@ -1,6 +1,13 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	"gopkg.in/yaml.v2"
 )
@@ -9,6 +16,14 @@ var (
 	_ proto.Message = &Merkledrop{}
 )
 
+const (
+	// MaxStartTimeDelay is the time-based equivalent of the 100_000 blocks start height window
+	MaxStartTimeDelay = 7 * 24 * time.Hour
+
+	// MaxDuration is the time-based equivalent of the 5_000_000 blocks end height window
+	MaxDuration = 365 * 24 * time.Hour
+)
+
 type MerkledropI interface {
 	GetMerkleRoot() string
 	GetAmount() string
@@ -19,10 +34,166 @@ func (m Merkledrop) GetMerkleRoot() string {
 }
 
 func (m Merkledrop) GetAmount() string {
-	return m.Amount.String()
+	return m.Coins.String()
 }
 
 func (m Merkledrop) String() string {
 	bz, _ := yaml.Marshal(m)
 	return string(bz)
 }
+
+// IsTimeBased returns true if the merkledrop window is defined by start and end time
+func (m Merkledrop) IsTimeBased() bool {
+	return m.EndTime != nil
+}
+
+// HasBegun returns true if the merkledrop can be claimed at the given height and time
+func (m Merkledrop) HasBegun(height int64, blockTime time.Time) bool {
+	if m.IsTimeBased() {
+		return m.StartTime == nil || !blockTime.Before(*m.StartTime)
+	}
+	return height >= m.StartHeight
+}
+
+// IsExpired returns true if the merkledrop is expired at the given height and time, the last block is included
+func (m Merkledrop) IsExpired(height int64, blockTime time.Time) bool {
+	if m.IsTimeBased() {
+		return !blockTime.Before(*m.EndTime)
+	}
+	return m.EndHeight <= height
+}
+
+// GetStatus returns the status of the merkledrop at the given height and time
+func (m Merkledrop) GetStatus(height int64, blockTime time.Time) MerkledropStatus {
+	switch {
+	case !m.HasBegun(height, blockTime):
+		return StatusPending
+	case m.IsExpired(height, blockTime):
+		return StatusExpired
+	default:
+		return StatusActive
+	}
+}
+
+// ValidateWindow checks the start and end time of a time-based merkledrop
+func (m Merkledrop) ValidateWindow() error {
+	if !m.IsTimeBased() {
+		if m.StartTime != nil {
+			return sdkerrors.Wrapf(ErrInvalidEndTime, "start time requires the end time")
+		}
+		return nil
+	}
+
+	if m.StartTime == nil {
+		return sdkerrors.Wrapf(ErrInvalidStartTime, "end time requires the start time")
+	}
+
+	if !m.EndTime.After(*m.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidEndTime, "end time must be > start time")
+	}
+
+	return nil
+}
+
+// MerkledropStatusFromString returns the merkledrop status from its name, an empty string matches any status
+func MerkledropStatusFromString(str string) (MerkledropStatus, error) {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "":
+		return StatusUnspecified, nil
+	case "pending":
+		return StatusPending, nil
+	case "active":
+		return StatusActive, nil
+	case "expired":
+		return StatusExpired, nil
+	default:
+		return StatusUnspecified, fmt.Errorf("'%s' is not a valid merkledrop status, expected pending, active or expired", str)
+	}
+}
+
+// GetCoins returns the coins to distribuite
+func (m Merkledrop) GetCoins() sdk.Coins {
+	return m.Coins
+}
+
+// GetUnclaimedCoins returns the coins still to be claimed
+func (m Merkledrop) GetUnclaimedCoins() sdk.Coins {
+	return m.Coins.Sub(m.ClaimedCoins)
+}
+
+// IsFullyClaimed returns true if all the coins of the merkledrop have been claimed
+func (m Merkledrop) IsFullyClaimed() bool {
+	return m.ClaimedCoins.IsAllGTE(m.Coins)
+}
+
+// VerifyClaim verifies the proofs of a claim according to the leaf version of the merkledrop,
+// and returns the claimed coins. The single denom merkledrops can be claimed either with the
+// amount or with a single coin of the merkledrop denom.
+func (m Merkledrop) VerifyClaim(index uint64, account sdk.AccAddress, amount sdk.Int, coins sdk.Coins, proofs [][]byte) (sdk.Coins, error) {
+	root, err := hex.DecodeString(m.MerkleRoot)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidMerkleRoot, "invalid merkle root (%s)", err)
+	}
+
+	switch m.LeafVersion {
+	case LeafVersion1:
+		if len(m.Coins) != 1 {
+			return nil, sdkerrors.Wrapf(ErrInvalidCoin, "leaf version %d requires a single denom", m.LeafVersion)
+		}
+
+		denom := m.Coins[0].Denom
+		if len(coins) > 0 {
+			if len(coins) != 1 || coins[0].Denom != denom {
+				return nil, sdkerrors.Wrapf(ErrInvalidCoin, "expected a single coin of %s, got %s", denom, coins)
+			}
+			amount = coins[0].Amount
+		}
+
+		if amount.IsNil() || !amount.IsPositive() {
+			return nil, sdkerrors.Wrapf(ErrInvalidCoin, "invalid claim amount")
+		}
+
+		if !IsValidProof(index, account, amount, root, proofs) {
+			return nil, sdkerrors.Wrapf(ErrInvalidMerkleProofs, "invalid proofs")
+		}
+
+		return sdk.NewCoins(sdk.NewCoin(denom, amount)), nil
+
+	case LeafVersion2:
+		if coins.Empty() {
+			return nil, sdkerrors.Wrapf(ErrInvalidCoin, "leaf version %d requires the claim coins", m.LeafVersion)
+		}
+
+		if !IsValidCoinsProof(index, account, coins, root, proofs) {
+			return nil, sdkerrors.Wrapf(ErrInvalidMerkleProofs, "invalid proofs")
+		}
+
+		return coins, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidLeafVersion, "leaf version %d", m.LeafVersion)
+	}
+}
+
+// ValidateCoins checks the coins and the leaf version of the merkledrop
+func (m Merkledrop) ValidateCoins() error {
+	switch m.LeafVersion {
+	case LeafVersion1:
+		if len(m.Coins) != 1 {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "leaf version %d requires a single denom", m.LeafVersion)
+		}
+	case LeafVersion2:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidLeafVersion, "leaf version %d", m.LeafVersion)
+	}
+
+	if err := m.Coins.Validate(); err != nil || m.Coins.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidCoin, "invalid coins %s", m.Coins)
+	}
+
+	if err := m.ClaimedCoins.Validate(); err != nil || !m.Coins.IsAllGTE(m.ClaimedCoins) {
+		return sdkerrors.Wrapf(ErrInvalidCoin, "invalid claimed coins %s", m.ClaimedCoins)
+	}
+
+	return nil
+}
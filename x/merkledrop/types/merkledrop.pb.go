@@ -5,20 +5,23 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +29,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MerkledropStatus defines the status of a merkledrop with respect to its window
+type MerkledropStatus int32
+
+const (
+	// MERKLEDROP_STATUS_UNSPECIFIED matches any status
+	StatusUnspecified MerkledropStatus = 0
+	// MERKLEDROP_STATUS_PENDING defines a merkledrop not yet begun
+	StatusPending MerkledropStatus = 1
+	// MERKLEDROP_STATUS_ACTIVE defines a merkledrop which can be claimed
+	StatusActive MerkledropStatus = 2
+	// MERKLEDROP_STATUS_EXPIRED defines a merkledrop expired but not yet withdrawn
+	StatusExpired MerkledropStatus = 3
+)
+
+var MerkledropStatus_name = map[int32]string{
+	0: "MERKLEDROP_STATUS_UNSPECIFIED",
+	1: "MERKLEDROP_STATUS_PENDING",
+	2: "MERKLEDROP_STATUS_ACTIVE",
+	3: "MERKLEDROP_STATUS_EXPIRED",
+}
+
+var MerkledropStatus_value = map[string]int32{
+	"MERKLEDROP_STATUS_UNSPECIFIED": 0,
+	"MERKLEDROP_STATUS_PENDING":     1,
+	"MERKLEDROP_STATUS_ACTIVE":      2,
+	"MERKLEDROP_STATUS_EXPIRED":     3,
+}
+
+func (x MerkledropStatus) String() string {
+	return proto.EnumName(MerkledropStatus_name, int32(x))
+}
+
+func (MerkledropStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21aba39fc2313837, []int{0}
+}
+
 type Merkledrop struct {
 	// merkledrop id
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -36,13 +75,27 @@ type Merkledrop struct {
 	// merkledrop end height
 	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 	// denom to distribuite
-	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Deprecated: moved into coins by the v2 store migration
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"` // Deprecated: Do not use.
 	// amount to distribuite
-	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// Deprecated: moved into coins by the v2 store migration
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"` // Deprecated: Do not use.
 	// claimed amount
-	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
+	// Deprecated: moved into claimed_coins by the v2 store migration
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"` // Deprecated: Do not use.
 	// merkledrop's owner
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// coins to distribuite
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// claimed coins
+	ClaimedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=claimed_coins,json=claimedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_coins" yaml:"claimed_coins"`
+	// leaf_version defines the encoding of the merkle tree leaves
+	LeafVersion uint32 `protobuf:"varint,11,opt,name=leaf_version,json=leafVersion,proto3" json:"leaf_version,omitempty" yaml:"leaf_version"`
+	// merkledrop start time, set for the time-based merkledrops
+	StartTime *time.Time `protobuf:"bytes,12,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// merkledrop end time, set for the time-based merkledrops which
+	// expire at the first block with a time greater or equal to it
+	EndTime *time.Time `protobuf:"bytes,13,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
 }
 
 func (m *Merkledrop) Reset()      { *m = Merkledrop{} }
@@ -78,6 +131,7 @@ func (m *Merkledrop) XXX_DiscardUnknown() {
 var xxx_messageInfo_Merkledrop proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("bitsong.merkledrop.v1beta1.MerkledropStatus", MerkledropStatus_name, MerkledropStatus_value)
 	proto.RegisterType((*Merkledrop)(nil), "bitsong.merkledrop.v1beta1.Merkledrop")
 }
 
@@ -86,33 +140,53 @@ func init() {
 }
 
 var fileDescriptor_21aba39fc2313837 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xb1, 0x6e, 0xdb, 0x30,
-	0x10, 0x95, 0x94, 0xc4, 0x49, 0x98, 0xa2, 0x03, 0x11, 0x14, 0x82, 0x80, 0x52, 0xae, 0x87, 0xc2,
-	0x40, 0x11, 0x11, 0x41, 0x87, 0x16, 0x19, 0x33, 0x14, 0xe9, 0xd0, 0x45, 0x43, 0x87, 0x2e, 0x06,
-	0x25, 0xd2, 0x34, 0x61, 0x91, 0x67, 0x88, 0x74, 0x5b, 0xff, 0x41, 0xc7, 0x8e, 0x1d, 0xfd, 0x39,
-	0x1e, 0x3d, 0x16, 0x1d, 0xdc, 0xd6, 0xfe, 0x83, 0x7e, 0x41, 0x61, 0x4a, 0x36, 0x34, 0x67, 0x92,
-	0xde, 0xbd, 0x77, 0xf7, 0x1e, 0x71, 0x87, 0x5e, 0x15, 0xca, 0x59, 0x30, 0x92, 0x6a, 0x51, 0x4f,
-	0x2b, 0xc1, 0x6b, 0x98, 0xd1, 0xcf, 0xb7, 0x85, 0x70, 0xec, 0xb6, 0x53, 0xca, 0x66, 0x35, 0x38,
-	0xc0, 0x49, 0x2b, 0xce, 0x3a, 0x4c, 0x2b, 0x4e, 0xae, 0x25, 0x48, 0xf0, 0x32, 0xba, 0xff, 0x6b,
-	0x3a, 0x92, 0x54, 0x02, 0xc8, 0x4a, 0x50, 0x8f, 0x8a, 0xf9, 0x98, 0x3a, 0xa5, 0x85, 0x75, 0x4c,
-	0xb7, 0x23, 0x13, 0x52, 0x82, 0xd5, 0x60, 0x69, 0xc1, 0xac, 0x38, 0x1a, 0x97, 0xa0, 0x4c, 0xc3,
-	0x0f, 0x7e, 0x47, 0x08, 0x7d, 0x38, 0xba, 0xe1, 0xa7, 0x28, 0x52, 0x3c, 0x0e, 0xfb, 0xe1, 0xf0,
-	0x34, 0x8f, 0x14, 0xc7, 0x6f, 0xd0, 0x55, 0x93, 0x65, 0x54, 0x03, 0xb8, 0x38, 0xea, 0x87, 0xc3,
-	0xcb, 0xfb, 0x67, 0xff, 0x36, 0x29, 0x5e, 0x30, 0x5d, 0xdd, 0x0d, 0x3a, 0xe4, 0x20, 0x47, 0x0d,
-	0xca, 0x01, 0x1c, 0x7e, 0x81, 0x9e, 0x58, 0xc7, 0x6a, 0x37, 0x9a, 0x08, 0x25, 0x27, 0x2e, 0x3e,
-	0xe9, 0x87, 0xc3, 0x93, 0xfc, 0xca, 0xd7, 0x1e, 0x7c, 0x09, 0x3f, 0x47, 0x48, 0x18, 0x7e, 0x10,
-	0x9c, 0x7a, 0xc1, 0xa5, 0x30, 0xbc, 0xa5, 0xaf, 0xd1, 0x19, 0x17, 0x06, 0x74, 0x7c, 0xb6, 0x37,
-	0xcd, 0x1b, 0x80, 0xdf, 0xa1, 0x1e, 0xd3, 0x30, 0x37, 0x2e, 0xee, 0xf9, 0x2c, 0xd9, 0x6a, 0x93,
-	0x06, 0xbf, 0x36, 0xe9, 0x4b, 0xa9, 0xdc, 0x64, 0x5e, 0x64, 0x25, 0x68, 0xda, 0x3e, 0xb9, 0xf9,
-	0xdc, 0x58, 0x3e, 0xa5, 0x6e, 0x31, 0x13, 0x36, 0x7b, 0x6f, 0x5c, 0xde, 0x76, 0xe3, 0x07, 0x74,
-	0x5e, 0x56, 0x4c, 0x69, 0xc1, 0xe3, 0xf3, 0x47, 0x0d, 0x3a, 0xb4, 0xef, 0x73, 0xc2, 0x17, 0x23,
-	0xea, 0xf8, 0xa2, 0xc9, 0xe9, 0xc1, 0xdd, 0xc5, 0xb7, 0x65, 0x1a, 0xfc, 0x58, 0xa6, 0xc1, 0xfd,
-	0xc7, 0xd5, 0x5f, 0x12, 0xac, 0xb6, 0x24, 0x5c, 0x6f, 0x49, 0xf8, 0x67, 0x4b, 0xc2, 0xef, 0x3b,
-	0x12, 0xac, 0x77, 0x24, 0xf8, 0xb9, 0x23, 0xc1, 0xa7, 0xb7, 0x1d, 0xbb, 0x76, 0xfb, 0x30, 0x1e,
-	0xab, 0x52, 0xb1, 0x8a, 0x4a, 0xb8, 0x39, 0x5c, 0xcf, 0xd7, 0xee, 0xfd, 0xf8, 0x10, 0x45, 0xcf,
-	0x2f, 0xf0, 0xf5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xe8, 0x3b, 0x8b, 0x62, 0x02, 0x00,
-	0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4e, 0xdb, 0x48,
+	0x18, 0xf7, 0x24, 0x24, 0x90, 0x49, 0xb2, 0x1b, 0x66, 0xd9, 0x5d, 0x63, 0x09, 0xdb, 0x9b, 0xc3,
+	0x2a, 0x6a, 0x85, 0x0d, 0xf4, 0x50, 0xc4, 0x8d, 0x10, 0xb7, 0xa4, 0x2d, 0x69, 0xe4, 0x04, 0x54,
+	0xf5, 0x12, 0x39, 0xf1, 0xc4, 0x8c, 0x88, 0x3d, 0x91, 0x3d, 0xa1, 0xf0, 0x06, 0x88, 0x13, 0xc7,
+	0x5e, 0x90, 0x90, 0x7a, 0xeb, 0x0b, 0xf4, 0x15, 0x38, 0x72, 0xac, 0x7a, 0x08, 0x2d, 0x48, 0x7d,
+	0x80, 0x3c, 0x41, 0x65, 0x8f, 0x03, 0xa9, 0xa8, 0x44, 0xdb, 0x53, 0xf2, 0x7d, 0xdf, 0xef, 0xcf,
+	0x97, 0x7c, 0x3f, 0x1b, 0x3e, 0x6c, 0x13, 0x16, 0x50, 0xcf, 0xd1, 0x5d, 0xec, 0xef, 0xf5, 0xb0,
+	0xed, 0xd3, 0xbe, 0xbe, 0xbf, 0xdc, 0xc6, 0xcc, 0x5a, 0x9e, 0x68, 0x69, 0x7d, 0x9f, 0x32, 0x8a,
+	0xa4, 0x18, 0xac, 0x4d, 0x4c, 0x62, 0xb0, 0x34, 0xe7, 0x50, 0x87, 0x46, 0x30, 0x3d, 0xfc, 0xc6,
+	0x19, 0x92, 0xe2, 0x50, 0xea, 0xf4, 0xb0, 0x1e, 0x55, 0xed, 0x41, 0x57, 0x67, 0xc4, 0xc5, 0x01,
+	0xb3, 0xdc, 0x58, 0x52, 0x92, 0x3b, 0x34, 0x70, 0x69, 0xa0, 0xb7, 0xad, 0x00, 0xdf, 0x18, 0x77,
+	0x28, 0xf1, 0xf8, 0xbc, 0xf8, 0x21, 0x0d, 0xe1, 0xd6, 0x8d, 0x1b, 0xfa, 0x03, 0x26, 0x88, 0x2d,
+	0x02, 0x15, 0x94, 0xa6, 0xcc, 0x04, 0xb1, 0xd1, 0x63, 0x98, 0xe5, 0xbb, 0xb4, 0x7c, 0x4a, 0x99,
+	0x98, 0x50, 0x41, 0x29, 0x53, 0xfe, 0x67, 0x34, 0x54, 0xd0, 0xa1, 0xe5, 0xf6, 0xd6, 0x8a, 0x13,
+	0xc3, 0xa2, 0x09, 0x79, 0x65, 0x52, 0xca, 0xd0, 0x7f, 0x30, 0x17, 0x30, 0xcb, 0x67, 0xad, 0x5d,
+	0x4c, 0x9c, 0x5d, 0x26, 0x26, 0x55, 0x50, 0x4a, 0x9a, 0xd9, 0xa8, 0xb7, 0x19, 0xb5, 0xd0, 0x02,
+	0x84, 0xd8, 0xb3, 0xc7, 0x80, 0xa9, 0x08, 0x90, 0xc1, 0x9e, 0x1d, 0x8f, 0x45, 0x98, 0xb2, 0xb1,
+	0x47, 0x5d, 0x31, 0x15, 0x99, 0x26, 0x44, 0x60, 0xf2, 0x06, 0xda, 0x84, 0x69, 0xcb, 0xa5, 0x03,
+	0x8f, 0x89, 0xe9, 0x68, 0xb4, 0x74, 0x3e, 0x54, 0x84, 0x4f, 0x43, 0xe5, 0x7f, 0x87, 0xb0, 0xdd,
+	0x41, 0x5b, 0xeb, 0x50, 0x57, 0x8f, 0x7f, 0x36, 0xff, 0x58, 0x0c, 0xec, 0x3d, 0x9d, 0x1d, 0xf6,
+	0x71, 0xa0, 0x55, 0x3d, 0x26, 0x02, 0x33, 0xe6, 0xa3, 0x67, 0x70, 0xba, 0xd3, 0xb3, 0x88, 0x8b,
+	0x6d, 0x71, 0xfa, 0x37, 0xa5, 0xc6, 0x02, 0x68, 0x0e, 0xa6, 0xe8, 0x1b, 0x0f, 0xfb, 0xe2, 0x4c,
+	0xa8, 0x64, 0xf2, 0x02, 0x59, 0x30, 0x15, 0xfe, 0xdb, 0x81, 0x98, 0x51, 0x93, 0xa5, 0xec, 0xca,
+	0xbc, 0xc6, 0x65, 0xb4, 0xf0, 0x1e, 0xe3, 0xdb, 0x6a, 0x1b, 0x94, 0x78, 0xdc, 0xfa, 0xfd, 0xa5,
+	0x52, 0xfa, 0x09, 0xeb, 0x90, 0x10, 0x98, 0x5c, 0x19, 0x1d, 0x01, 0x98, 0x8f, 0x97, 0x68, 0x71,
+	0x2f, 0x78, 0x9f, 0xd7, 0x66, 0xe8, 0x35, 0x1a, 0x2a, 0x73, 0xfc, 0x8a, 0xdf, 0xb1, 0x8b, 0xbf,
+	0xb4, 0x43, 0x2e, 0xe6, 0x46, 0x15, 0x5a, 0x83, 0xb9, 0x1e, 0xb6, 0xba, 0xad, 0x7d, 0xec, 0x07,
+	0x84, 0x7a, 0x62, 0x56, 0x05, 0xa5, 0x7c, 0xf9, 0xdf, 0xd1, 0x50, 0xf9, 0x8b, 0x3b, 0x4d, 0x4e,
+	0x8b, 0x66, 0x36, 0x2c, 0x77, 0x78, 0x85, 0x9a, 0x10, 0xf2, 0xc4, 0x84, 0x11, 0x16, 0x73, 0x2a,
+	0x28, 0x65, 0x57, 0x24, 0x8d, 0xe7, 0x5b, 0x1b, 0xe7, 0x5b, 0x6b, 0x8e, 0xf3, 0x5d, 0x9e, 0x1f,
+	0x0d, 0x95, 0x59, 0xae, 0x7a, 0xcb, 0x2b, 0x9e, 0x5c, 0x2a, 0xc0, 0xcc, 0x44, 0x8d, 0x10, 0x8a,
+	0x6a, 0x70, 0x26, 0x0c, 0x59, 0xa4, 0x99, 0xbf, 0x57, 0x33, 0xdc, 0xf4, 0x4f, 0xae, 0x39, 0x66,
+	0x71, 0xc5, 0x69, 0xec, 0xd9, 0x21, 0x6c, 0x6d, 0xe6, 0xe8, 0x4c, 0x11, 0xde, 0x9e, 0x29, 0xc2,
+	0x83, 0xaf, 0x00, 0x16, 0x6e, 0x9f, 0x9c, 0x06, 0xb3, 0xd8, 0x20, 0x40, 0xab, 0x70, 0x61, 0xcb,
+	0x30, 0x9f, 0xbf, 0x30, 0x2a, 0xe6, 0xcb, 0x7a, 0xab, 0xd1, 0x5c, 0x6f, 0x6e, 0x37, 0x5a, 0xdb,
+	0xb5, 0x46, 0xdd, 0xd8, 0xa8, 0x3e, 0xa9, 0x1a, 0x95, 0x82, 0x20, 0xfd, 0x7d, 0x7c, 0xaa, 0xce,
+	0x72, 0xf8, 0xb6, 0x17, 0xf4, 0x71, 0x87, 0x74, 0x09, 0xb6, 0xd1, 0x12, 0x9c, 0xbf, 0xcb, 0xac,
+	0x1b, 0xb5, 0x4a, 0xb5, 0xf6, 0xb4, 0x00, 0xa4, 0xd9, 0xe3, 0x53, 0x35, 0xcf, 0x59, 0x75, 0xec,
+	0xd9, 0xc4, 0x73, 0x90, 0x06, 0xc5, 0xbb, 0x8c, 0xf5, 0x8d, 0x66, 0x75, 0xc7, 0x28, 0x24, 0xa4,
+	0xc2, 0xf1, 0xa9, 0x9a, 0xe3, 0x84, 0xf5, 0x0e, 0x23, 0xfb, 0xf8, 0xc7, 0x0e, 0xc6, 0xab, 0x7a,
+	0xd5, 0x34, 0x2a, 0x85, 0xe4, 0xa4, 0x83, 0x71, 0xd0, 0x27, 0x3e, 0xb6, 0xa5, 0xa9, 0xa3, 0x77,
+	0xb2, 0x50, 0xde, 0x39, 0xff, 0x22, 0x0b, 0xe7, 0x57, 0x32, 0xb8, 0xb8, 0x92, 0xc1, 0xe7, 0x2b,
+	0x19, 0x9c, 0x5c, 0xcb, 0xc2, 0xc5, 0xb5, 0x2c, 0x7c, 0xbc, 0x96, 0x85, 0xd7, 0xab, 0x13, 0x51,
+	0x89, 0x5f, 0x5f, 0xb4, 0xdb, 0x25, 0x1d, 0x62, 0xf5, 0x74, 0x87, 0x2e, 0xc6, 0x2d, 0xfd, 0x60,
+	0xf2, 0x05, 0x18, 0x05, 0xa8, 0x9d, 0x8e, 0x0e, 0xf0, 0xe8, 0xdb, 0x00, 0xad, 0x52, 0x3f, 0x19,
+	0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *Merkledrop) Marshal() (dAtA []byte, err error) {
@@ -135,6 +209,59 @@ func (m *Merkledrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMerkledrop(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.StartTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMerkledrop(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LeafVersion != 0 {
+		i = encodeVarintMerkledrop(dAtA, i, uint64(m.LeafVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ClaimedCoins) > 0 {
+		for iNdEx := len(m.ClaimedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMerkledrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMerkledrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -236,6 +363,29 @@ func (m *Merkledrop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMerkledrop(uint64(l))
 	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovMerkledrop(uint64(l))
+		}
+	}
+	if len(m.ClaimedCoins) > 0 {
+		for _, e := range m.ClaimedCoins {
+			l = e.Size()
+			n += 1 + l + sovMerkledrop(uint64(l))
+		}
+	}
+	if m.LeafVersion != 0 {
+		n += 1 + sovMerkledrop(uint64(m.LeafVersion))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMerkledrop(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovMerkledrop(uint64(l))
+	}
 	return n
 }
 
@@ -495,6 +645,165 @@ func (m *Merkledrop) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkledrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkledrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedCoins = append(m.ClaimedCoins, types.Coin{})
+			if err := m.ClaimedCoins[len(m.ClaimedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafVersion", wireType)
+			}
+			m.LeafVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkledrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkledrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkledrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkledrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMerkledrop(dAtA[iNdEx:])
@@ -4,11 +4,17 @@ import (
 	"encoding/hex"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"time"
 )
 
 const (
-	TypeMsgCreate = "create"
-	TypeMsgClaim  = "claim"
+	TypeMsgCreate   = "create"
+	TypeMsgClaim    = "claim"
+	TypeMsgWithdraw = "withdraw"
+	TypeMsgFund     = "fund"
+	TypeMsgExtend   = "extend"
+
+	TypeMsgUpdateParams = "update_params"
 )
 
 var _ sdk.Msg = &MsgCreate{}
@@ -23,21 +29,79 @@ func NewMsgCreate(owner sdk.AccAddress, merkleRoot string, startHeight, endHeigh
 	}
 }
 
+// NewMsgCreateWithCoins creates a multi denom merkledrop, whose leaves are encoded with the leaf version 2
+func NewMsgCreateWithCoins(owner sdk.AccAddress, merkleRoot string, startHeight, endHeight int64, coins sdk.Coins) *MsgCreate {
+	return &MsgCreate{
+		Owner:       owner.String(),
+		MerkleRoot:  merkleRoot,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Coin:        sdk.Coin{Amount: sdk.ZeroInt()},
+		Coins:       coins,
+	}
+}
+
 func (msg MsgCreate) Route() string { return RouterKey }
 
 func (msg MsgCreate) Type() string { return TypeMsgCreate }
 
-func (msg MsgCreate) ValidateBasic() error {
-	if msg.EndHeight <= msg.StartHeight {
-		return sdkerrors.Wrapf(ErrInvalidEndHeight, "end height must be > start height")
+// IsTimeBased returns true if the merkledrop to be created is time-based
+func (msg MsgCreate) IsTimeBased() bool {
+	return msg.EndTime != nil
+}
+
+// hasCoin returns true if the single denom coin is set
+func (msg MsgCreate) hasCoin() bool {
+	return msg.Coin.Denom != "" || !(msg.Coin.Amount.IsNil() || msg.Coin.Amount.IsZero())
+}
+
+// GetLeafVersion returns the leaf version of the merkledrop to be created
+func (msg MsgCreate) GetLeafVersion() uint32 {
+	if len(msg.Coins) > 0 {
+		return LeafVersion2
 	}
+	return LeafVersion1
+}
 
-	if err := msg.Coin.Validate(); err != nil {
-		return err
+// GetMerkledropCoins returns the coins of the merkledrop to be created
+func (msg MsgCreate) GetMerkledropCoins() sdk.Coins {
+	if len(msg.Coins) > 0 {
+		return msg.Coins
 	}
+	return sdk.Coins{msg.Coin}
+}
+
+func (msg MsgCreate) ValidateBasic() error {
+	if msg.IsTimeBased() {
+		if msg.StartTime != nil && !msg.EndTime.After(*msg.StartTime) {
+			return sdkerrors.Wrapf(ErrInvalidEndTime, "end time must be > start time")
+		}
+	} else {
+		if msg.StartTime != nil {
+			return sdkerrors.Wrapf(ErrInvalidStartTime, "start time requires the end time")
+		}
 
-	if msg.Coin.Amount.LTE(sdk.ZeroInt()) {
-		return sdkerrors.Wrapf(ErrInvalidCoin, "invalid coin amount, less then zero")
+		if msg.EndHeight <= msg.StartHeight {
+			return sdkerrors.Wrapf(ErrInvalidEndHeight, "end height must be > start height")
+		}
+	}
+
+	if len(msg.Coins) > 0 {
+		if msg.hasCoin() {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "coin and coins cannot be set together")
+		}
+
+		if err := msg.Coins.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "%s", err)
+		}
+	} else {
+		if err := msg.Coin.Validate(); err != nil {
+			return err
+		}
+
+		if msg.Coin.Amount.LTE(sdk.ZeroInt()) {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "invalid coin amount, less then zero")
+		}
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Owner)
@@ -50,6 +114,12 @@ func (msg MsgCreate) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidMerkleRoot, "invalid merkle root (%s)", err)
 	}
 
+	if len(msg.FeeDenom) > 0 {
+		if err := sdk.ValidateDenom(msg.FeeDenom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee denom (%s)", err)
+		}
+	}
+
 	return nil
 }
 
@@ -83,6 +153,18 @@ func NewMsgClaim(index, mdId uint64, amount sdk.Int, proofs []string, sender sdk
 	}
 }
 
+// NewMsgClaimWithCoins claims the coins of a multi denom merkledrop
+func NewMsgClaimWithCoins(index, mdId uint64, coins sdk.Coins, proofs []string, sender sdk.AccAddress) *MsgClaim {
+	return &MsgClaim{
+		Index:        index,
+		MerkledropId: mdId,
+		Amount:       sdk.ZeroInt(),
+		Proofs:       proofs,
+		Sender:       sender.String(),
+		Coins:        coins,
+	}
+}
+
 func (msg MsgClaim) Route() string { return RouterKey }
 
 func (msg MsgClaim) Type() string { return TypeMsgClaim }
@@ -95,9 +177,51 @@ func (msg MsgClaim) ValidateBasic() error {
 		}
 	}
 
+	if len(msg.Coins) > 0 {
+		if !msg.Amount.IsNil() && !msg.Amount.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "amount and coins cannot be set together")
+		}
+
+		if err := msg.Coins.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidCoin, "%s", err)
+		}
+	}
+
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBeneficiary, "beneficiary %s", msg.Beneficiary)
+		}
+	}
+
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRecipient, "recipient %s", msg.Recipient)
+		}
+
+		if msg.Recipient != msg.GetBeneficiaryOrSender() && (len(msg.RecipientSignature) == 0 || len(msg.BeneficiaryPubKey) == 0) {
+			return sdkerrors.Wrapf(ErrInvalidSignature, "the recipient requires the signature and the public key of the beneficiary")
+		}
+	}
+
 	return nil
 }
 
+// GetBeneficiaryOrSender returns the address of the claimed leaf, the sender if the beneficiary is not set
+func (msg MsgClaim) GetBeneficiaryOrSender() string {
+	if msg.Beneficiary != "" {
+		return msg.Beneficiary
+	}
+	return msg.Sender
+}
+
+// GetRecipientOrBeneficiary returns the address receiving the claimed coins
+func (msg MsgClaim) GetRecipientOrBeneficiary() string {
+	if msg.Recipient != "" {
+		return msg.Recipient
+	}
+	return msg.GetBeneficiaryOrSender()
+}
+
 // GetSignBytes Implements Msg.
 func (msg MsgClaim) GetSignBytes() []byte {
 	b, err := ModuleCdc.MarshalJSON(&msg)
@@ -115,3 +239,191 @@ func (msg MsgClaim) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgWithdraw{}
+
+func NewMsgWithdraw(owner sdk.AccAddress, mdId uint64) *MsgWithdraw {
+	return &MsgWithdraw{
+		Owner:        owner.String(),
+		MerkledropId: mdId,
+	}
+}
+
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+func (msg MsgWithdraw) Type() string { return TypeMsgWithdraw }
+
+func (msg MsgWithdraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgFund{}
+
+func NewMsgFund(owner sdk.AccAddress, mdId uint64, coins sdk.Coins) *MsgFund {
+	return &MsgFund{
+		Owner:        owner.String(),
+		MerkledropId: mdId,
+		Coins:        coins,
+	}
+}
+
+func (msg MsgFund) Route() string { return RouterKey }
+
+func (msg MsgFund) Type() string { return TypeMsgFund }
+
+func (msg MsgFund) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCoin, "%s", err)
+	}
+
+	if msg.Coins.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidCoin, "invalid coins amount, must be greater then zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFund) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFund) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgExtend{}
+
+func NewMsgExtend(owner sdk.AccAddress, mdId uint64, endHeight int64) *MsgExtend {
+	return &MsgExtend{
+		Owner:        owner.String(),
+		MerkledropId: mdId,
+		EndHeight:    endHeight,
+	}
+}
+
+// NewMsgExtendTime pushes out the end time of a time-based merkledrop
+func NewMsgExtendTime(owner sdk.AccAddress, mdId uint64, endTime time.Time) *MsgExtend {
+	return &MsgExtend{
+		Owner:        owner.String(),
+		MerkledropId: mdId,
+		EndTime:      &endTime,
+	}
+}
+
+func (msg MsgExtend) Route() string { return RouterKey }
+
+func (msg MsgExtend) Type() string { return TypeMsgExtend }
+
+func (msg MsgExtend) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if msg.EndTime != nil {
+		if msg.EndHeight != 0 {
+			return sdkerrors.Wrapf(ErrInvalidEndHeight, "end height and end time cannot be set together")
+		}
+	} else if msg.EndHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEndHeight, "end height must be greater then zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgExtend) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExtend) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a MsgUpdateParams
+func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
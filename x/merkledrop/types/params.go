@@ -2,27 +2,45 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	feestypes "github.com/bitsongofficial/go-bitsong/x/fees/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyCreationFee = []byte("CreationFee")
+	KeyCreationFee           = []byte("CreationFee")
+	KeyWithdrawGracePeriod   = []byte("WithdrawGracePeriod")
+	KeyWithdrawGraceDuration = []byte("WithdrawGraceDuration")
+)
+
+const (
+	// DefaultWithdrawGracePeriod is about one week of blocks
+	DefaultWithdrawGracePeriod int64 = 100_000
+
+	// DefaultWithdrawGraceDuration is the time-based equivalent of DefaultWithdrawGracePeriod
+	DefaultWithdrawGraceDuration = 7 * 24 * time.Hour
 )
 
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyCreationFee, &p.CreationFee, validateCreationFee),
+		paramtypes.NewParamSetPair(KeyWithdrawGracePeriod, &p.WithdrawGracePeriod, validateWithdrawGracePeriod),
+		paramtypes.NewParamSetPair(KeyWithdrawGraceDuration, &p.WithdrawGraceDuration, validateWithdrawGraceDuration),
 	}
 }
 
 // NewParams constructs a new Params instance
-func NewParams(creationFee sdk.Coin) Params {
+func NewParams(creationFee []sdk.Coin, withdrawGracePeriod int64, withdrawGraceDuration time.Duration) Params {
 	return Params{
-		CreationFee: creationFee,
+		CreationFee:           creationFee,
+		WithdrawGracePeriod:   withdrawGracePeriod,
+		WithdrawGraceDuration: withdrawGraceDuration,
 	}
 }
 
@@ -34,7 +52,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams return the default params
 func DefaultParams() Params {
 	return Params{
-		CreationFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000),
+		CreationFee:           []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)},
+		WithdrawGracePeriod:   DefaultWithdrawGracePeriod,
+		WithdrawGraceDuration: DefaultWithdrawGraceDuration,
 	}
 }
 
@@ -50,17 +70,51 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateWithdrawGracePeriod(p.WithdrawGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateWithdrawGraceDuration(p.WithdrawGraceDuration); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func validateCreationFee(i interface{}) error {
-	v, ok := i.(sdk.Coin)
+	v, ok := i.([]sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := feestypes.ValidateAcceptedFees(v); err != nil {
+		return fmt.Errorf("invalid creation fee: %s", err)
+	}
+
+	return nil
+}
+
+func validateWithdrawGracePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("withdraw grace period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateWithdrawGraceDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.Validate() != nil {
-		return fmt.Errorf("invalid creation fee: %+v", i)
+	if v < 0 {
+		return fmt.Errorf("withdraw grace duration must be positive: %s", v)
 	}
 
 	return nil
@@ -5,19 +5,22 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,7 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines merkledrop module's parameters
 type Params struct {
-	CreationFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=creation_fee,json=creationFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"creation_fee" yaml:"creation_fee"`
+	// creation_fee lists the accepted fees for creating a merkledrop, the first
+	// one is charged when the owner does not choose a fee denom
+	CreationFee []types.Coin `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3" json:"creation_fee" yaml:"creation_fee"`
+	// withdraw_grace_period is the number of blocks, after the start height, from
+	// which the owner can withdraw the unclaimed coins of a merkledrop
+	WithdrawGracePeriod int64 `protobuf:"varint,2,opt,name=withdraw_grace_period,json=withdrawGracePeriod,proto3" json:"withdraw_grace_period,omitempty" yaml:"withdraw_grace_period"`
+	// withdraw_grace_duration is the duration, after the start time, from which
+	// the owner can withdraw the unclaimed coins of a time-based merkledrop
+	WithdrawGraceDuration time.Duration `protobuf:"bytes,3,opt,name=withdraw_grace_duration,json=withdrawGraceDuration,proto3,stdduration" json:"withdraw_grace_duration" yaml:"withdraw_grace_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -71,25 +82,31 @@ func init() {
 }
 
 var fileDescriptor_547c41e8e8fc0d00 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xca, 0x2c, 0x29,
-	0xce, 0xcf, 0x4b, 0xd7, 0xcf, 0x4d, 0x2d, 0xca, 0xce, 0x49, 0x4d, 0x29, 0xca, 0x2f, 0xd0, 0x2f,
-	0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x82, 0x2a, 0xd4, 0x43, 0x28, 0xd4, 0x83, 0x2a, 0x94, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd3, 0x07, 0xb1, 0x20, 0x3a, 0xa4, 0xe4, 0x92, 0xf3, 0x8b, 0x73,
-	0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0xe1, 0x66, 0x26, 0xe7, 0x67, 0xe6, 0x41, 0xe4, 0x95,
-	0x66, 0x32, 0x72, 0xb1, 0x05, 0x80, 0xad, 0x10, 0x6a, 0x61, 0xe4, 0xe2, 0x49, 0x2e, 0x4a, 0x4d,
-	0x2c, 0xc9, 0xcc, 0xcf, 0x8b, 0x4f, 0x4b, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92,
-	0xd4, 0x83, 0x18, 0xa1, 0x07, 0x32, 0x02, 0x66, 0x9b, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x93, 0xdb,
-	0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25,
-	0xe7, 0xe7, 0xea, 0x43, 0xed, 0x83, 0x50, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x60, 0x0d, 0x9f, 0xee, 0xc9, 0x0b, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x21, 0xdb, 0xa3,
-	0x14, 0xc4, 0x0d, 0xe3, 0xba, 0xa5, 0xa6, 0x5a, 0x71, 0xcc, 0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81,
-	0x3c, 0xa3, 0x53, 0xd8, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x81, 0x64, 0x29, 0x34, 0x58, 0xf2, 0xd3, 0xd2, 0x32, 0x93, 0x33, 0x13, 0x73,
-	0xf4, 0xd3, 0xf3, 0x75, 0x61, 0x41, 0x5a, 0x81, 0x1c, 0xa8, 0x60, 0xa7, 0x24, 0xb1, 0x81, 0xbd,
-	0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x55, 0x98, 0xfc, 0x6e, 0x77, 0x01, 0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0x86, 0xbd, 0x20, 0xa1, 0xc8, 0xa4, 0x32, 0x41, 0x21, 0x24, 0x5a, 0x5b, 0x6e, 0x82, 0x22,
+	0x65, 0x57, 0x24, 0x4d, 0x44, 0xe9, 0x44, 0x49, 0x8b, 0x50, 0x14, 0x29, 0x69, 0xd0, 0xda, 0x5e,
+	0x2f, 0xab, 0xd8, 0x8c, 0xb5, 0x36, 0x21, 0x14, 0x79, 0x87, 0x94, 0x94, 0x3c, 0xcb, 0x55, 0x94,
+	0x94, 0x57, 0x71, 0x77, 0xd0, 0x5c, 0xcd, 0x13, 0x9c, 0xb0, 0xbd, 0x77, 0xdc, 0x89, 0xce, 0xf3,
+	0xcf, 0x37, 0xff, 0xfc, 0x1e, 0xdb, 0x7c, 0xeb, 0xcb, 0x3c, 0x83, 0xa9, 0xa0, 0x09, 0x57, 0xbf,
+	0x63, 0x1e, 0x2a, 0x48, 0xe9, 0x9f, 0xbe, 0xcf, 0x73, 0xd6, 0xa7, 0x29, 0x53, 0x2c, 0xc9, 0x48,
+	0xaa, 0x20, 0x07, 0xab, 0x5b, 0x81, 0xe4, 0x01, 0x24, 0x15, 0xd8, 0x7d, 0x21, 0x40, 0x40, 0x81,
+	0xd1, 0xe3, 0x53, 0x39, 0xd1, 0xc5, 0x02, 0x40, 0xc4, 0x9c, 0x16, 0x95, 0x3f, 0x8b, 0x68, 0x38,
+	0x53, 0x2c, 0x97, 0x30, 0xd5, 0xfd, 0x00, 0xb2, 0x04, 0x32, 0xea, 0xb3, 0x8c, 0xdf, 0xef, 0x0c,
+	0x40, 0x56, 0x7d, 0xf7, 0xa2, 0x66, 0x36, 0x86, 0x45, 0x04, 0xeb, 0xa7, 0xf9, 0x3c, 0x50, 0xbc,
+	0x18, 0x1e, 0x47, 0x9c, 0x77, 0x90, 0x53, 0xef, 0x35, 0x3f, 0xbc, 0x22, 0xa5, 0x03, 0x39, 0x3a,
+	0xe8, 0x30, 0xe4, 0x33, 0xc8, 0xa9, 0xf7, 0x7a, 0xbd, 0xb5, 0x8d, 0xc3, 0xd6, 0x6e, 0x2d, 0x58,
+	0x12, 0x0f, 0xdc, 0xd3, 0x61, 0x77, 0xd4, 0xd4, 0xe5, 0x57, 0xce, 0xad, 0xef, 0x66, 0x7b, 0x2e,
+	0xf3, 0x49, 0xa8, 0xd8, 0x7c, 0x2c, 0x14, 0x0b, 0xf8, 0x38, 0xe5, 0x4a, 0x42, 0xd8, 0xa9, 0x39,
+	0xa8, 0x57, 0xf7, 0x9c, 0xc3, 0xd6, 0x7e, 0x53, 0x9a, 0x9c, 0xc5, 0xdc, 0x51, 0x4b, 0xeb, 0xdf,
+	0x8e, 0xf2, 0xb0, 0x50, 0xad, 0x7f, 0xe6, 0xcb, 0x27, 0xb8, 0x7e, 0xf9, 0x4e, 0xdd, 0x41, 0x45,
+	0xf6, 0xf2, 0x3a, 0x44, 0x5f, 0x87, 0x7c, 0xa9, 0x00, 0xef, 0x5d, 0x95, 0x1d, 0x9f, 0x5d, 0xab,
+	0x7d, 0xdc, 0xe5, 0x95, 0x8d, 0x46, 0xed, 0x47, 0xcb, 0xb5, 0xc5, 0xe0, 0xd9, 0x72, 0x65, 0x1b,
+	0xb7, 0x2b, 0x1b, 0x79, 0x3f, 0xd6, 0x37, 0xd8, 0x58, 0xef, 0x30, 0xda, 0xec, 0x30, 0xba, 0xde,
+	0x61, 0xf4, 0x7f, 0x8f, 0x8d, 0xcd, 0x1e, 0x1b, 0x97, 0x7b, 0x6c, 0xfc, 0xfa, 0x24, 0x64, 0x3e,
+	0x99, 0xf9, 0x24, 0x80, 0x84, 0x56, 0xdf, 0x17, 0xa2, 0x48, 0x06, 0x92, 0xc5, 0x54, 0xc0, 0xfb,
+	0x4a, 0xa2, 0x7f, 0x4f, 0xff, 0x8e, 0x7c, 0x91, 0xf2, 0xcc, 0x6f, 0x14, 0xb9, 0x3f, 0xde, 0x0d,
+	0x00, 0x94, 0x57, 0xfd, 0x52, 0x40, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -111,7 +128,18 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CreationFee.Equal(that1.CreationFee) {
+	if len(this.CreationFee) != len(that1.CreationFee) {
+		return false
+	}
+	for i := range this.CreationFee {
+		if !this.CreationFee[i].Equal(&that1.CreationFee[i]) {
+			return false
+		}
+	}
+	if this.WithdrawGracePeriod != that1.WithdrawGracePeriod {
+		return false
+	}
+	if this.WithdrawGraceDuration != that1.WithdrawGraceDuration {
 		return false
 	}
 	return true
@@ -136,16 +164,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.CreationFee.Size()
-		i -= size
-		if _, err := m.CreationFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.WithdrawGraceDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawGraceDuration):])
+	if err1 != nil {
+		return 0, err1
 	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if m.WithdrawGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawGracePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -166,7 +211,16 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.CreationFee.Size()
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.WithdrawGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawGracePeriod))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.WithdrawGraceDuration)
 	n += 1 + l + sovParams(uint64(l))
 	return n
 }
@@ -235,7 +289,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawGracePeriod", wireType)
+			}
+			m.WithdrawGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawGraceDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.WithdrawGraceDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
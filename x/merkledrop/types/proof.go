@@ -6,10 +6,20 @@ import (
 	"encoding/hex"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/tendermint/tendermint/crypto"
 	"strconv"
 )
 
+const (
+	// LeafVersion1 encodes the leaves as index+address+amount, for single denom merkledrops
+	LeafVersion1 uint32 = 1
+
+	// LeafVersion2 encodes the leaves as the length-prefixed fields of index, address and coins,
+	// for multi denom merkledrops
+	LeafVersion2 uint32 = 2
+)
+
 func ConvertProofs(proofs []string) [][]byte {
 	var proofsBz = make([][]byte, len(proofs))
 	for i := 0; i < len(proofs); i++ {
@@ -20,15 +30,41 @@ func ConvertProofs(proofs []string) [][]byte {
 	return proofsBz
 }
 
+// LeafV1 returns the leaf of a single denom merkledrop
+func LeafV1(index uint64, account sdk.AccAddress, amount sdk.Int) []byte {
+	indexStr := strconv.FormatUint(index, 10)
+	return []byte(fmt.Sprintf("%s%s%s", indexStr, account.String(), amount.String()))
+}
+
+// LeafV2 returns the leaf of a multi denom merkledrop. The leaf starts with the "v2"
+// version, followed by the big endian index, the length-prefixed address bytes and the
+// length-prefixed denom and amount of every coin, so that the encoding is unambiguous
+// whatever the characters allowed in the denoms by the sdk denom regex, which can be
+// customized by the chains (e.g. to allow a colon). The coins are expected in their
+// canonical (sorted) form.
+func LeafV2(index uint64, account sdk.AccAddress, coins sdk.Coins) []byte {
+	leaf := append([]byte("v2"), sdk.Uint64ToBigEndian(index)...)
+	leaf = append(leaf, address.MustLengthPrefix(account)...)
+	for _, coin := range coins {
+		leaf = append(leaf, address.MustLengthPrefix([]byte(coin.Denom))...)
+		leaf = append(leaf, address.MustLengthPrefix([]byte(coin.Amount.String()))...)
+	}
+	return leaf
+}
+
 func IsValidProof(index uint64, account sdk.AccAddress, amount sdk.Int, root []byte, proofs [][]byte) bool {
+	return verifyProof(LeafV1(index, account, amount), root, proofs)
+}
+
+// IsValidCoinsProof verifies the proofs of a multi denom merkledrop leaf
+func IsValidCoinsProof(index uint64, account sdk.AccAddress, coins sdk.Coins, root []byte, proofs [][]byte) bool {
+	return verifyProof(LeafV2(index, account, coins), root, proofs)
+}
+
+func verifyProof(leaf []byte, root []byte, proofs [][]byte) bool {
 	hasher := sha256.New()
 
-	indexStr := strconv.FormatUint(index, 10)
-	hashBz := crypto.Sha256(
-		[]byte(
-			fmt.Sprintf("%s%s%s", indexStr, account.String(), amount.String()),
-		),
-	)
+	hashBz := crypto.Sha256(leaf)
 
 	for _, p := range proofs {
 		hasher.Reset()
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"github.com/bitsongofficial/go-bitsong/app/params"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto"
 	"testing"
 )
 
@@ -27,3 +30,104 @@ func TestIsValidProof(t *testing.T) {
 	result := IsValidProof(uint64(0), address, amount, root, ConvertProofs(proofs))
 	assert.True(t, result)
 }
+
+func TestIsValidCoinsProof(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	address, err := sdk.AccAddressFromBech32("bitsong1vgpsha4f8grmsqr6krfdxwpcf3x20h0q3ztaj2")
+	assert.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubtsg", 1000000), sdk.NewInt64Coin("ftfoo", 10))
+
+	// two leaves tree
+	leaf := crypto.Sha256(LeafV2(0, address, coins))
+	sibling := crypto.Sha256(LeafV2(1, address, coins))
+	var root []byte
+	if bytes.Compare(leaf, sibling) < 0 {
+		root = crypto.Sha256(append(append([]byte{}, leaf...), sibling...))
+	} else {
+		root = crypto.Sha256(append(append([]byte{}, sibling...), leaf...))
+	}
+
+	assert.True(t, IsValidCoinsProof(0, address, coins, root, [][]byte{sibling}))
+	assert.False(t, IsValidCoinsProof(0, address, coins.Add(sdk.NewInt64Coin("ubtsg", 1)), root, [][]byte{sibling}))
+	assert.False(t, IsValidCoinsProof(1, address, coins, root, [][]byte{leaf[:len(leaf)-1]}))
+
+	// a v1 leaf with the same values must not verify against a v2 root
+	assert.False(t, IsValidProof(0, address, sdk.NewInt(1000000), root, [][]byte{sibling}))
+}
+
+func TestIsValidProof_Beneficiary(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	beneficiary, err := sdk.AccAddressFromBech32("bitsong1vgpsha4f8grmsqr6krfdxwpcf3x20h0q3ztaj2")
+	assert.NoError(t, err)
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	amount := sdk.NewInt(1000000)
+	root, _ := hex.DecodeString("5eb39dbca442a25db0f5d9e63489451b7bfc173796aa221e7207839de3a59e79")
+	proofs := ConvertProofs([]string{
+		"7f0b92cc8318e4fb0db9052325b474e2eabb80d79e6e1abab92093d3a88fe029",
+		"a258c32bee9b0bbb7a2d1999ab4698294844e7440aa6dcd067e0d5142fa20522",
+	})
+
+	// the proofs are bound to the leaf address, not to the signer of the claim
+	assert.True(t, IsValidProof(0, beneficiary, amount, root, proofs))
+	assert.False(t, IsValidProof(0, sender, amount, root, proofs))
+
+	msg := NewMsgClaim(0, 1, amount, []string{}, sender)
+	assert.Equal(t, sender.String(), msg.GetBeneficiaryOrSender())
+	msg.Beneficiary = beneficiary.String()
+	assert.Equal(t, beneficiary.String(), msg.GetBeneficiaryOrSender())
+	assert.Equal(t, beneficiary.String(), msg.GetRecipientOrBeneficiary())
+	assert.NoError(t, msg.ValidateBasic())
+}
+
+func TestVerifyRecipientAuthorization(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	beneficiary := sdk.AccAddress(key.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	signature, err := key.Sign(RecipientAuthorizationSignBytes("bitsong-1", 1, 10, recipient))
+	assert.NoError(t, err)
+	pubKey := key.PubKey().Bytes()
+
+	assert.NoError(t, VerifyRecipientAuthorization("bitsong-1", 1, 10, beneficiary, recipient, pubKey, signature))
+
+	// the signature is bound to the chain, the merkledrop, the index and the recipient
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-2", 1, 10, beneficiary, recipient, pubKey, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-1", 2, 10, beneficiary, recipient, pubKey, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-1", 1, 11, beneficiary, recipient, pubKey, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-1", 1, 10, beneficiary, beneficiary, pubKey, signature), ErrInvalidSignature)
+
+	// the public key must be the beneficiary one
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-1", 1, 10, recipient, recipient, pubKey, signature), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyRecipientAuthorization("bitsong-1", 1, 10, beneficiary, recipient, pubKey[1:], signature), ErrInvalidSignature)
+}
+
+func TestLeafV2(t *testing.T) {
+	params.SetAddressPrefixes()
+
+	address, err := sdk.AccAddressFromBech32("bitsong1vgpsha4f8grmsqr6krfdxwpcf3x20h0q3ztaj2")
+	assert.NoError(t, err)
+
+	// the denom regex can be customized to allow a colon
+	coins := sdk.Coins{{Denom: "ibc:foo", Amount: sdk.NewInt(10)}, sdk.NewInt64Coin("ubtsg", 1000)}
+
+	leaf := LeafV2(1, address, coins)
+	expected := append([]byte("v2"), 0, 0, 0, 0, 0, 0, 0, 1, byte(len(address)))
+	expected = append(expected, address...)
+	expected = append(expected, 7)
+	expected = append(expected, "ibc:foo"...)
+	expected = append(expected, 2)
+	expected = append(expected, "10"...)
+	expected = append(expected, 5)
+	expected = append(expected, "ubtsg"...)
+	expected = append(expected, 4)
+	expected = append(expected, "1000"...)
+	assert.Equal(t, expected, leaf)
+
+	// moving the characters across the fields changes the leaf
+	assert.NotEqual(t, leaf, LeafV2(1, address, sdk.Coins{{Denom: "ibc:foo1", Amount: sdk.NewInt(0)}, sdk.NewInt64Coin("ubtsg", 1000)}))
+	assert.NotEqual(t, leaf, LeafV2(1, address, sdk.Coins{{Denom: "ibc:foo", Amount: sdk.NewInt(101)}, sdk.NewInt64Coin("btsg", 1000)}))
+}
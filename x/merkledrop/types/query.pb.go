@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -104,6 +105,165 @@ func (m *QueryMerkledropResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMerkledropResponse proto.InternalMessageInfo
 
+type QueryMerkledropsRequest struct {
+	// status filters the merkledrops, unspecified for any status
+	Status MerkledropStatus `protobuf:"varint,1,opt,name=status,proto3,enum=bitsong.merkledrop.v1beta1.MerkledropStatus" json:"status,omitempty"`
+	// denom filters the merkledrops distributing it, empty for any denom
+	Denom      string             `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkledropsRequest) Reset()         { *m = QueryMerkledropsRequest{} }
+func (m *QueryMerkledropsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkledropsRequest) ProtoMessage()    {}
+func (*QueryMerkledropsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{2}
+}
+func (m *QueryMerkledropsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkledropsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkledropsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkledropsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkledropsRequest.Merge(m, src)
+}
+func (m *QueryMerkledropsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkledropsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkledropsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkledropsRequest proto.InternalMessageInfo
+
+type QueryMerkledropsResponse struct {
+	Merkledrops []Merkledrop        `protobuf:"bytes,1,rep,name=merkledrops,proto3" json:"merkledrops"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkledropsResponse) Reset()         { *m = QueryMerkledropsResponse{} }
+func (m *QueryMerkledropsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkledropsResponse) ProtoMessage()    {}
+func (*QueryMerkledropsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{3}
+}
+func (m *QueryMerkledropsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkledropsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkledropsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkledropsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkledropsResponse.Merge(m, src)
+}
+func (m *QueryMerkledropsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkledropsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkledropsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkledropsResponse proto.InternalMessageInfo
+
+type QueryMerkledropsByOwnerRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status filters the merkledrops, unspecified for any status
+	Status MerkledropStatus `protobuf:"varint,2,opt,name=status,proto3,enum=bitsong.merkledrop.v1beta1.MerkledropStatus" json:"status,omitempty"`
+	// denom filters the merkledrops distributing it, empty for any denom
+	Denom      string             `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkledropsByOwnerRequest) Reset()         { *m = QueryMerkledropsByOwnerRequest{} }
+func (m *QueryMerkledropsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkledropsByOwnerRequest) ProtoMessage()    {}
+func (*QueryMerkledropsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{4}
+}
+func (m *QueryMerkledropsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkledropsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkledropsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkledropsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkledropsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryMerkledropsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkledropsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkledropsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkledropsByOwnerRequest proto.InternalMessageInfo
+
+type QueryMerkledropsByOwnerResponse struct {
+	Merkledrops []Merkledrop        `protobuf:"bytes,1,rep,name=merkledrops,proto3" json:"merkledrops"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMerkledropsByOwnerResponse) Reset()         { *m = QueryMerkledropsByOwnerResponse{} }
+func (m *QueryMerkledropsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkledropsByOwnerResponse) ProtoMessage()    {}
+func (*QueryMerkledropsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{5}
+}
+func (m *QueryMerkledropsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkledropsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkledropsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkledropsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkledropsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryMerkledropsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkledropsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkledropsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkledropsByOwnerResponse proto.InternalMessageInfo
+
 type QueryIndexClaimedRequest struct {
 	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -113,7 +273,7 @@ func (m *QueryIndexClaimedRequest) Reset()         { *m = QueryIndexClaimedReque
 func (m *QueryIndexClaimedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexClaimedRequest) ProtoMessage()    {}
 func (*QueryIndexClaimedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34bc458987e39e5e, []int{2}
+	return fileDescriptor_34bc458987e39e5e, []int{6}
 }
 func (m *QueryIndexClaimedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,7 +310,7 @@ func (m *QueryIndexClaimedResponse) Reset()         { *m = QueryIndexClaimedResp
 func (m *QueryIndexClaimedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIndexClaimedResponse) ProtoMessage()    {}
 func (*QueryIndexClaimedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34bc458987e39e5e, []int{3}
+	return fileDescriptor_34bc458987e39e5e, []int{7}
 }
 func (m *QueryIndexClaimedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,6 +339,87 @@ func (m *QueryIndexClaimedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryIndexClaimedResponse proto.InternalMessageInfo
 
+type QueryClaimedIndexesRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination over the claimed bitmap words of 64 indexes, in ascending order
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimedIndexesRequest) Reset()         { *m = QueryClaimedIndexesRequest{} }
+func (m *QueryClaimedIndexesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimedIndexesRequest) ProtoMessage()    {}
+func (*QueryClaimedIndexesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{8}
+}
+func (m *QueryClaimedIndexesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimedIndexesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimedIndexesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimedIndexesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimedIndexesRequest.Merge(m, src)
+}
+func (m *QueryClaimedIndexesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimedIndexesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimedIndexesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimedIndexesRequest proto.InternalMessageInfo
+
+// QueryClaimedIndexesResponse returns the claimed indexes of the page as a
+// bitmap: the index bitmap_offset + i is claimed if the bit i % 8 of the byte
+// i / 8 is set
+type QueryClaimedIndexesResponse struct {
+	BitmapOffset uint64              `protobuf:"varint,1,opt,name=bitmap_offset,json=bitmapOffset,proto3" json:"bitmap_offset,omitempty" yaml:"bitmap_offset"`
+	Bitmap       []byte              `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimedIndexesResponse) Reset()         { *m = QueryClaimedIndexesResponse{} }
+func (m *QueryClaimedIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimedIndexesResponse) ProtoMessage()    {}
+func (*QueryClaimedIndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34bc458987e39e5e, []int{9}
+}
+func (m *QueryClaimedIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimedIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimedIndexesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimedIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimedIndexesResponse.Merge(m, src)
+}
+func (m *QueryClaimedIndexesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimedIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimedIndexesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimedIndexesResponse proto.InternalMessageInfo
+
 // QueryParametersRequest is request type for the Query/Parameters RPC method
 type QueryParamsRequest struct {
 }
@@ -187,7 +428,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34bc458987e39e5e, []int{4}
+	return fileDescriptor_34bc458987e39e5e, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +466,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34bc458987e39e5e, []int{5}
+	return fileDescriptor_34bc458987e39e5e, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,8 +498,14 @@ var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*QueryMerkledropRequest)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropRequest")
 	proto.RegisterType((*QueryMerkledropResponse)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropResponse")
+	proto.RegisterType((*QueryMerkledropsRequest)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropsRequest")
+	proto.RegisterType((*QueryMerkledropsResponse)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropsResponse")
+	proto.RegisterType((*QueryMerkledropsByOwnerRequest)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropsByOwnerRequest")
+	proto.RegisterType((*QueryMerkledropsByOwnerResponse)(nil), "bitsong.merkledrop.v1beta1.QueryMerkledropsByOwnerResponse")
 	proto.RegisterType((*QueryIndexClaimedRequest)(nil), "bitsong.merkledrop.v1beta1.QueryIndexClaimedRequest")
 	proto.RegisterType((*QueryIndexClaimedResponse)(nil), "bitsong.merkledrop.v1beta1.QueryIndexClaimedResponse")
+	proto.RegisterType((*QueryClaimedIndexesRequest)(nil), "bitsong.merkledrop.v1beta1.QueryClaimedIndexesRequest")
+	proto.RegisterType((*QueryClaimedIndexesResponse)(nil), "bitsong.merkledrop.v1beta1.QueryClaimedIndexesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "bitsong.merkledrop.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "bitsong.merkledrop.v1beta1.QueryParamsResponse")
 }
@@ -268,40 +515,62 @@ func init() {
 }
 
 var fileDescriptor_34bc458987e39e5e = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x8a, 0xd3, 0x40,
-	0x18, 0x4f, 0x4a, 0xb7, 0xe8, 0xa7, 0x08, 0x8e, 0x55, 0x6b, 0x90, 0xac, 0x0c, 0xb2, 0x2e, 0xfe,
-	0xc9, 0xb0, 0xdd, 0x0a, 0xe2, 0x69, 0xa9, 0x5e, 0x14, 0x05, 0xb7, 0x07, 0x05, 0x2f, 0x32, 0x49,
-	0x66, 0xe3, 0x60, 0x92, 0xc9, 0x66, 0x52, 0xd9, 0xb2, 0xec, 0xc5, 0x27, 0x10, 0x44, 0x7c, 0x08,
-	0x1f, 0xc4, 0x1e, 0x17, 0xbc, 0x78, 0x5a, 0xb4, 0xf5, 0x09, 0x7c, 0x02, 0xe9, 0xcc, 0x74, 0xd3,
-	0xc5, 0x6e, 0x6a, 0x6f, 0xcd, 0x37, 0xbf, 0x7f, 0xf9, 0x7d, 0xd3, 0xc0, 0x9a, 0xcf, 0x0b, 0x29,
-	0xd2, 0x88, 0x24, 0x2c, 0x7f, 0x17, 0xb3, 0x30, 0x17, 0x19, 0x79, 0xbf, 0xe1, 0xb3, 0x82, 0x6e,
-	0x90, 0xdd, 0x3e, 0xcb, 0x07, 0x5e, 0x96, 0x8b, 0x42, 0x20, 0xc7, 0xe0, 0xbc, 0x12, 0xe7, 0x19,
-	0x9c, 0xd3, 0x8c, 0x44, 0x24, 0x14, 0x8c, 0x4c, 0x7e, 0x69, 0x86, 0x73, 0x3d, 0x12, 0x22, 0x8a,
-	0x19, 0xa1, 0x19, 0x27, 0x34, 0x4d, 0x45, 0x41, 0x0b, 0x2e, 0x52, 0x69, 0x4e, 0xdd, 0x40, 0xc8,
-	0x44, 0x48, 0xe2, 0x53, 0xc9, 0x8e, 0x0d, 0x03, 0xc1, 0x53, 0x73, 0x7e, 0xa7, 0x22, 0xd7, 0x4c,
-	0x04, 0x0d, 0xbe, 0x55, 0x01, 0xce, 0x68, 0x4e, 0x13, 0xe3, 0x8a, 0xd7, 0xe1, 0xca, 0xf6, 0xe4,
-	0xa5, 0x9e, 0x1f, 0xe3, 0x7a, 0x6c, 0xb7, 0xcf, 0x64, 0x81, 0x2e, 0x40, 0x8d, 0x87, 0x2d, 0xfb,
-	0x86, 0xbd, 0x5e, 0xef, 0xd5, 0x78, 0x88, 0x23, 0xb8, 0xfa, 0x0f, 0x52, 0x66, 0x22, 0x95, 0x0c,
-	0x3d, 0x03, 0x28, 0x7d, 0x14, 0xe5, 0x5c, 0x7b, 0xcd, 0x3b, 0xbd, 0x1f, 0xaf, 0xd4, 0xe8, 0xd6,
-	0x87, 0x47, 0xab, 0x56, 0x6f, 0x86, 0x8f, 0xb7, 0xa0, 0xa5, 0x8c, 0x9e, 0xa4, 0x21, 0xdb, 0x7b,
-	0x14, 0x53, 0x9e, 0xb0, 0xf0, 0x94, 0x50, 0xa8, 0x09, 0x2b, 0x7c, 0x02, 0x6b, 0xd5, 0xd4, 0x48,
-	0x3f, 0xe0, 0x6d, 0xb8, 0x36, 0x47, 0xc1, 0x84, 0xed, 0x00, 0x70, 0xf9, 0x26, 0xd0, 0x53, 0x25,
-	0x75, 0xa6, 0x7b, 0xf9, 0xcf, 0xd1, 0xea, 0xc5, 0x01, 0x4d, 0xe2, 0x87, 0xb8, 0x3c, 0xc3, 0xbd,
-	0xb3, 0x5c, 0x1a, 0x36, 0x6e, 0x02, 0x52, 0x92, 0x2f, 0x54, 0x79, 0x26, 0x0e, 0x7e, 0x05, 0x97,
-	0x4e, 0x4c, 0x8d, 0xc5, 0x16, 0x34, 0x74, 0xc9, 0xa6, 0x0b, 0x5c, 0xd5, 0x85, 0xe6, 0x9a, 0x1e,
-	0x0c, 0xaf, 0xfd, 0xa5, 0x0e, 0x2b, 0x4a, 0x19, 0x7d, 0xb5, 0x01, 0xca, 0xba, 0x50, 0xbb, 0x4a,
-	0x6a, 0xfe, 0x26, 0x9d, 0xcd, 0xa5, 0x38, 0xfa, 0x1d, 0x70, 0xe7, 0xc3, 0xf7, 0xdf, 0x9f, 0x6a,
-	0x1e, 0xba, 0x4b, 0xaa, 0xee, 0x1d, 0x9d, 0x8e, 0x24, 0xd9, 0xe7, 0xe1, 0x01, 0xfa, 0x66, 0xc3,
-	0xf9, 0xd9, 0xd6, 0x51, 0x67, 0xa1, 0xf7, 0x9c, 0x35, 0x3b, 0xf7, 0x97, 0x64, 0x99, 0xcc, 0x4f,
-	0x55, 0xe6, 0xc7, 0xa8, 0xbb, 0x4c, 0x66, 0xa2, 0xee, 0xcc, 0x74, 0xe9, 0x64, 0x5f, 0x3d, 0x1e,
-	0xa0, 0xcf, 0x36, 0x34, 0xf4, 0x6a, 0x90, 0xb7, 0x30, 0xcd, 0x89, 0x5b, 0xe1, 0x90, 0xff, 0xc6,
-	0x9b, 0xdc, 0xb7, 0x55, 0xee, 0x9b, 0x08, 0x93, 0x85, 0x7f, 0xdb, 0xee, 0xcb, 0xe1, 0x2f, 0xd7,
-	0x1a, 0x8e, 0x5c, 0xfb, 0x70, 0xe4, 0xda, 0x3f, 0x47, 0xae, 0xfd, 0x71, 0xec, 0x5a, 0x87, 0x63,
-	0xd7, 0xfa, 0x31, 0x76, 0xad, 0xd7, 0x0f, 0x22, 0x5e, 0xbc, 0xed, 0xfb, 0x5e, 0x20, 0x92, 0xa9,
-	0x96, 0xd8, 0xd9, 0xe1, 0x01, 0xa7, 0x31, 0x89, 0xc4, 0xbd, 0xa9, 0xfc, 0xde, 0xac, 0x41, 0x31,
-	0xc8, 0x98, 0xf4, 0x1b, 0xea, 0x7b, 0xb0, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xeb, 0x44,
-	0xaf, 0xff, 0x04, 0x00, 0x00,
+	// 870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd8, 0x8e, 0xd5, 0xbc, 0x84, 0x48, 0x0c, 0xa6, 0x98, 0x05, 0xad, 0xab, 0x15, 0x6a,
+	0x42, 0x29, 0x3b, 0xaa, 0x6b, 0x4a, 0x55, 0xa0, 0xaa, 0x4c, 0x54, 0x04, 0x02, 0xda, 0x2e, 0x12,
+	0x48, 0x5c, 0xa2, 0xb5, 0x3d, 0x5e, 0x46, 0x78, 0x77, 0xb6, 0x9e, 0x35, 0xc4, 0x8a, 0x72, 0xe1,
+	0x13, 0x20, 0x21, 0x3e, 0x04, 0xe2, 0xc8, 0x0d, 0x29, 0xe7, 0xe4, 0x82, 0x14, 0x89, 0x0b, 0x42,
+	0x22, 0x82, 0x84, 0x4f, 0x90, 0x4f, 0x80, 0x3c, 0x33, 0x1b, 0xef, 0xc6, 0x7f, 0xd7, 0xe2, 0xd0,
+	0x93, 0xfd, 0x76, 0xde, 0xef, 0xbd, 0xdf, 0xef, 0xbd, 0x37, 0x6f, 0x17, 0xae, 0x37, 0x59, 0x24,
+	0x78, 0xe0, 0x11, 0x9f, 0xf6, 0xbe, 0xee, 0xd2, 0x76, 0x8f, 0x87, 0xe4, 0x9b, 0x5b, 0x4d, 0x1a,
+	0xb9, 0xb7, 0xc8, 0xd3, 0x3e, 0xed, 0x0d, 0xec, 0xb0, 0xc7, 0x23, 0x8e, 0x0d, 0xed, 0x67, 0x8f,
+	0xfc, 0x6c, 0xed, 0x67, 0x94, 0x3d, 0xee, 0x71, 0xe9, 0x46, 0x86, 0xff, 0x14, 0xc2, 0x78, 0xd5,
+	0xe3, 0xdc, 0xeb, 0x52, 0xe2, 0x86, 0x8c, 0xb8, 0x41, 0xc0, 0x23, 0x37, 0x62, 0x3c, 0x10, 0xfa,
+	0xd4, 0x6c, 0x71, 0xe1, 0x73, 0x41, 0x9a, 0xae, 0xa0, 0x17, 0x09, 0x5b, 0x9c, 0x05, 0xfa, 0xfc,
+	0x46, 0xf2, 0x5c, 0x12, 0xb9, 0xf0, 0x0a, 0x5d, 0x8f, 0x05, 0x32, 0x98, 0xf6, 0x7d, 0x63, 0x86,
+	0x86, 0x04, 0x5d, 0xe5, 0xbc, 0x39, 0xc3, 0x39, 0x74, 0x7b, 0xae, 0xaf, 0x19, 0x5a, 0x5b, 0x70,
+	0xf5, 0xc9, 0x30, 0xef, 0x27, 0x17, 0x7e, 0x0e, 0x7d, 0xda, 0xa7, 0x22, 0xc2, 0x1b, 0x90, 0x67,
+	0xed, 0x0a, 0xba, 0x86, 0xb6, 0x8a, 0x4e, 0x9e, 0xb5, 0x2d, 0x0f, 0x5e, 0x1a, 0xf3, 0x14, 0x21,
+	0x0f, 0x04, 0xc5, 0x1f, 0x03, 0x8c, 0xf2, 0x48, 0xc8, 0x5a, 0xed, 0xba, 0x3d, 0xbd, 0x96, 0xf6,
+	0x28, 0x46, 0xa3, 0x78, 0x74, 0x52, 0xcd, 0x39, 0x09, 0xbc, 0x75, 0x80, 0xc6, 0x32, 0x89, 0x98,
+	0xd4, 0x36, 0x94, 0x44, 0xe4, 0x46, 0x7d, 0x21, 0xb3, 0x6c, 0xd4, 0x6e, 0x2e, 0x96, 0xe5, 0x33,
+	0x89, 0x71, 0x34, 0x16, 0x97, 0x61, 0xa5, 0x4d, 0x03, 0xee, 0x57, 0xf2, 0xd7, 0xd0, 0xd6, 0xaa,
+	0xa3, 0x0c, 0xfc, 0x10, 0x60, 0x54, 0xf4, 0x4a, 0x41, 0xab, 0x50, 0x1d, 0xb2, 0x87, 0x1d, 0xb2,
+	0xd5, 0xa8, 0xc4, 0xe1, 0x1f, 0xbb, 0x1e, 0xd5, 0xbc, 0x9c, 0x04, 0xd2, 0xfa, 0x05, 0x41, 0x65,
+	0x9c, 0xbf, 0x2e, 0xd5, 0xa7, 0xb0, 0x36, 0x62, 0x3a, 0x54, 0x51, 0xc8, 0x5c, 0xab, 0x64, 0x00,
+	0xfc, 0x41, 0x8a, 0x74, 0x5e, 0x92, 0xde, 0x9c, 0x4b, 0x5a, 0x91, 0x49, 0xb1, 0xfe, 0x13, 0x81,
+	0x79, 0x99, 0x75, 0x63, 0xf0, 0xe8, 0xdb, 0x80, 0xf6, 0xe2, 0xe2, 0x97, 0x61, 0x85, 0x0f, 0x6d,
+	0x59, 0xfb, 0x55, 0x47, 0x19, 0x89, 0x96, 0xe4, 0xff, 0x8f, 0x96, 0x14, 0xa6, 0xb7, 0xa4, 0xb8,
+	0x74, 0x4b, 0x7e, 0x45, 0x50, 0x9d, 0x2a, 0xee, 0x59, 0xef, 0xcc, 0x03, 0x3d, 0x4e, 0x1f, 0x06,
+	0x6d, 0xba, 0xfb, 0x7e, 0xd7, 0x65, 0x3e, 0x6d, 0x4f, 0xb9, 0xa4, 0xc3, 0x32, 0xb2, 0xa1, 0x9b,
+	0xcc, 0x57, 0x74, 0x94, 0x61, 0x3d, 0x81, 0x97, 0x27, 0x44, 0xd0, 0xba, 0xeb, 0x00, 0x4c, 0xec,
+	0xb4, 0xd4, 0x53, 0x19, 0xea, 0x4a, 0xe3, 0xc5, 0xf3, 0x93, 0xea, 0xf3, 0x03, 0xd7, 0xef, 0xde,
+	0xb3, 0x46, 0x67, 0x96, 0xb3, 0xca, 0x84, 0x46, 0x5b, 0x11, 0x18, 0x32, 0xa4, 0xb6, 0x65, 0x64,
+	0x2a, 0xa6, 0xd1, 0x7a, 0x38, 0xa1, 0x16, 0xcb, 0xf4, 0xf1, 0x00, 0xc1, 0x2b, 0x13, 0xd3, 0x6a,
+	0x2d, 0xef, 0xc1, 0x73, 0x4d, 0x16, 0xf9, 0x6e, 0xb8, 0xc3, 0x3b, 0x1d, 0x41, 0x23, 0x45, 0xa1,
+	0x51, 0x39, 0x3f, 0xa9, 0x96, 0x95, 0x9c, 0xd4, 0xb1, 0xe5, 0xac, 0x2b, 0xfb, 0x91, 0x34, 0xf1,
+	0x55, 0x28, 0x29, 0x5b, 0x52, 0x5c, 0x77, 0xb4, 0x75, 0xa9, 0x95, 0x85, 0xe5, 0x5b, 0x59, 0x06,
+	0x2c, 0xe9, 0x3f, 0x96, 0x2b, 0x58, 0x2b, 0xb4, 0xbe, 0x80, 0x17, 0x52, 0x4f, 0xb5, 0x98, 0x07,
+	0x50, 0x52, 0xab, 0x5a, 0x6f, 0x54, 0x6b, 0xd6, 0x2c, 0x2a, 0xac, 0x9e, 0x43, 0x8d, 0xab, 0xfd,
+	0x75, 0x05, 0x56, 0x64, 0x64, 0xfc, 0x33, 0x02, 0x18, 0x8d, 0x2b, 0xae, 0xcd, 0x0a, 0x35, 0xf9,
+	0x7d, 0x60, 0xdc, 0xce, 0x84, 0x51, 0x1a, 0xac, 0xfa, 0x77, 0xbf, 0xff, 0xfb, 0x43, 0xde, 0xc6,
+	0x37, 0xc9, 0xac, 0xb7, 0x97, 0x1b, 0x3f, 0x12, 0x64, 0x8f, 0xb5, 0xf7, 0xf1, 0x21, 0x82, 0xf5,
+	0xe4, 0xac, 0xe2, 0xfa, 0xdc, 0xdc, 0x13, 0x2e, 0x87, 0xf1, 0x56, 0x46, 0x94, 0xe6, 0xfc, 0x91,
+	0xe4, 0xbc, 0x8d, 0x1b, 0x59, 0x38, 0x13, 0x79, 0xd3, 0xe2, 0xab, 0x42, 0xf6, 0xa4, 0xb9, 0x8f,
+	0x7f, 0x42, 0xb0, 0x96, 0xd8, 0x39, 0x38, 0x4b, 0x11, 0xe3, 0xf9, 0x30, 0xea, 0xd9, 0x40, 0x5a,
+	0x06, 0x91, 0x32, 0x5e, 0xc7, 0x9b, 0x64, 0xa1, 0x0f, 0x07, 0x81, 0x7f, 0x43, 0x80, 0xc7, 0xf7,
+	0x23, 0xbe, 0x97, 0x25, 0x7b, 0xfa, 0x8d, 0x61, 0xbc, 0xb3, 0x14, 0x56, 0x0b, 0xb8, 0x2f, 0x05,
+	0xdc, 0xc5, 0x77, 0x66, 0x09, 0x90, 0xef, 0x20, 0x41, 0xf6, 0xe4, 0xef, 0x7e, 0x4a, 0xcf, 0x21,
+	0x82, 0x8d, 0xf4, 0x9e, 0xc0, 0x77, 0xe6, 0xf2, 0x99, 0xb8, 0xcf, 0x8c, 0xb7, 0x33, 0xe3, 0xb4,
+	0x86, 0x6d, 0xa9, 0xe1, 0x3e, 0x7e, 0x77, 0xc1, 0x26, 0xa8, 0x59, 0xd2, 0x53, 0xb4, 0xc3, 0x34,
+	0xed, 0x1f, 0x11, 0x94, 0xd4, 0x05, 0xc7, 0xf6, 0x5c, 0x26, 0xa9, 0xdd, 0x62, 0x90, 0x85, 0xfd,
+	0x35, 0xe3, 0x1b, 0x92, 0xf1, 0x6b, 0xd8, 0x22, 0x73, 0x3f, 0x21, 0x1b, 0x9f, 0x1f, 0xfd, 0x63,
+	0xe6, 0x8e, 0x4e, 0x4d, 0x74, 0x7c, 0x6a, 0xa2, 0xbf, 0x4f, 0x4d, 0xf4, 0xfd, 0x99, 0x99, 0x3b,
+	0x3e, 0x33, 0x73, 0x7f, 0x9c, 0x99, 0xb9, 0x2f, 0xef, 0x7a, 0x2c, 0xfa, 0xaa, 0xdf, 0xb4, 0x5b,
+	0xdc, 0x8f, 0x63, 0xf1, 0x4e, 0x87, 0xb5, 0x98, 0xdb, 0x25, 0x1e, 0x7f, 0x33, 0x0e, 0xbf, 0x9b,
+	0x4c, 0x10, 0x0d, 0x42, 0x2a, 0x9a, 0x25, 0xf9, 0x6d, 0x7a, 0xfb, 0xbf, 0x01, 0x00, 0xa9, 0xf4,
+	0x6b, 0xa2, 0xb7, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -318,6 +587,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	Merkledrop(ctx context.Context, in *QueryMerkledropRequest, opts ...grpc.CallOption) (*QueryMerkledropResponse, error)
 	IndexClaimed(ctx context.Context, in *QueryIndexClaimedRequest, opts ...grpc.CallOption) (*QueryIndexClaimedResponse, error)
+	// Merkledrops returns the merkledrops filtered by status and denom
+	Merkledrops(ctx context.Context, in *QueryMerkledropsRequest, opts ...grpc.CallOption) (*QueryMerkledropsResponse, error)
+	// MerkledropsByOwner returns the merkledrops of an owner filtered by status and denom
+	MerkledropsByOwner(ctx context.Context, in *QueryMerkledropsByOwnerRequest, opts ...grpc.CallOption) (*QueryMerkledropsByOwnerResponse, error)
+	// ClaimedIndexes returns a bitmap of the claimed indexes of a merkledrop
+	ClaimedIndexes(ctx context.Context, in *QueryClaimedIndexesRequest, opts ...grpc.CallOption) (*QueryClaimedIndexesResponse, error)
 	// Params queries the fantoken parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -348,6 +623,33 @@ func (c *queryClient) IndexClaimed(ctx context.Context, in *QueryIndexClaimedReq
 	return out, nil
 }
 
+func (c *queryClient) Merkledrops(ctx context.Context, in *QueryMerkledropsRequest, opts ...grpc.CallOption) (*QueryMerkledropsResponse, error) {
+	out := new(QueryMerkledropsResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.merkledrop.v1beta1.Query/Merkledrops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkledropsByOwner(ctx context.Context, in *QueryMerkledropsByOwnerRequest, opts ...grpc.CallOption) (*QueryMerkledropsByOwnerResponse, error) {
+	out := new(QueryMerkledropsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.merkledrop.v1beta1.Query/MerkledropsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimedIndexes(ctx context.Context, in *QueryClaimedIndexesRequest, opts ...grpc.CallOption) (*QueryClaimedIndexesResponse, error) {
+	out := new(QueryClaimedIndexesResponse)
+	err := c.cc.Invoke(ctx, "/bitsong.merkledrop.v1beta1.Query/ClaimedIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/bitsong.merkledrop.v1beta1.Query/Params", in, out, opts...)
@@ -361,6 +663,12 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	Merkledrop(context.Context, *QueryMerkledropRequest) (*QueryMerkledropResponse, error)
 	IndexClaimed(context.Context, *QueryIndexClaimedRequest) (*QueryIndexClaimedResponse, error)
+	// Merkledrops returns the merkledrops filtered by status and denom
+	Merkledrops(context.Context, *QueryMerkledropsRequest) (*QueryMerkledropsResponse, error)
+	// MerkledropsByOwner returns the merkledrops of an owner filtered by status and denom
+	MerkledropsByOwner(context.Context, *QueryMerkledropsByOwnerRequest) (*QueryMerkledropsByOwnerResponse, error)
+	// ClaimedIndexes returns a bitmap of the claimed indexes of a merkledrop
+	ClaimedIndexes(context.Context, *QueryClaimedIndexesRequest) (*QueryClaimedIndexesResponse, error)
 	// Params queries the fantoken parameters
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -375,6 +683,15 @@ func (*UnimplementedQueryServer) Merkledrop(ctx context.Context, req *QueryMerkl
 func (*UnimplementedQueryServer) IndexClaimed(ctx context.Context, req *QueryIndexClaimedRequest) (*QueryIndexClaimedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexClaimed not implemented")
 }
+func (*UnimplementedQueryServer) Merkledrops(ctx context.Context, req *QueryMerkledropsRequest) (*QueryMerkledropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merkledrops not implemented")
+}
+func (*UnimplementedQueryServer) MerkledropsByOwner(ctx context.Context, req *QueryMerkledropsByOwnerRequest) (*QueryMerkledropsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkledropsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ClaimedIndexes(ctx context.Context, req *QueryClaimedIndexesRequest) (*QueryClaimedIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimedIndexes not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -419,6 +736,60 @@ func _Query_IndexClaimed_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Merkledrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkledropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Merkledrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.merkledrop.v1beta1.Query/Merkledrops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Merkledrops(ctx, req.(*QueryMerkledropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkledropsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkledropsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkledropsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.merkledrop.v1beta1.Query/MerkledropsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkledropsByOwner(ctx, req.(*QueryMerkledropsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimedIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimedIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimedIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitsong.merkledrop.v1beta1.Query/ClaimedIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimedIndexes(ctx, req.(*QueryClaimedIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -449,6 +820,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IndexClaimed",
 			Handler:    _Query_IndexClaimed_Handler,
 		},
+		{
+			MethodName: "Merkledrops",
+			Handler:    _Query_Merkledrops_Handler,
+		},
+		{
+			MethodName: "MerkledropsByOwner",
+			Handler:    _Query_MerkledropsByOwner_Handler,
+		},
+		{
+			MethodName: "ClaimedIndexes",
+			Handler:    _Query_ClaimedIndexes_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -519,7 +902,7 @@ func (m *QueryMerkledropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndexClaimedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkledropsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -529,30 +912,44 @@ func (m *QueryIndexClaimedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIndexClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIndexClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIndexClaimedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkledropsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -562,30 +959,46 @@ func (m *QueryIndexClaimedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIndexClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIndexClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsClaimed {
-		i--
-		if m.IsClaimed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Merkledrops) > 0 {
+		for iNdEx := len(m.Merkledrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merkledrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkledropsByOwnerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -595,20 +1008,51 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMerkledropsByOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -618,21 +1062,246 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMerkledropsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merkledrops) > 0 {
+		for iNdEx := len(m.Merkledrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merkledrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsClaimed {
+		i--
+		if m.IsClaimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedIndexesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedIndexesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedIndexesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedIndexesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedIndexesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedIndexesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BitmapOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitmapOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
@@ -675,6 +1344,88 @@ func (m *QueryMerkledropResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMerkledropsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkledropsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merkledrops) > 0 {
+		for _, e := range m.Merkledrops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkledropsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMerkledropsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Merkledrops) > 0 {
+		for _, e := range m.Merkledrops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryIndexClaimedRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -702,33 +1453,767 @@ func (m *QueryIndexClaimedResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryClaimedIndexesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedIndexesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	if m.BitmapOffset != 0 {
+		n += 1 + sovQuery(uint64(m.BitmapOffset))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMerkledropRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkledropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merkledrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Merkledrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkledropsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MerkledropStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkledropsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merkledrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merkledrops = append(m.Merkledrops, Merkledrop{})
+			if err := m.Merkledrops[len(m.Merkledrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkledropsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MerkledropStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkledropsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkledropsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkledropsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merkledrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merkledrops = append(m.Merkledrops, Merkledrop{})
+			if err := m.Merkledrops[len(m.Merkledrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryMerkledropRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -751,10 +2236,10 @@ func (m *QueryMerkledropRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkledropRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexClaimedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkledropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -776,6 +2261,25 @@ func (m *QueryMerkledropRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -797,7 +2301,7 @@ func (m *QueryMerkledropRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMerkledropResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexClaimedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,17 +2324,17 @@ func (m *QueryMerkledropResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMerkledropResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexClaimedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMerkledropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merkledrop", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsClaimed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -840,25 +2344,12 @@ func (m *QueryMerkledropResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Merkledrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsClaimed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -880,7 +2371,7 @@ func (m *QueryMerkledropResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedIndexesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -903,10 +2394,10 @@ func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexClaimedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedIndexesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedIndexesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -929,10 +2420,10 @@ func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -942,11 +2433,28 @@ func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -968,7 +2476,7 @@ func (m *QueryIndexClaimedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIndexClaimedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedIndexesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -991,17 +2499,17 @@ func (m *QueryIndexClaimedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIndexClaimedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedIndexesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIndexClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitmapOffset", wireType)
 			}
-			var v int
+			m.BitmapOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1011,12 +2519,81 @@ func (m *QueryIndexClaimedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BitmapOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsClaimed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"strconv"
+)
+
+type recipientAuthorization struct {
+	ChainID      string `json:"chain_id"`
+	MerkledropID string `json:"merkledrop_id"`
+	Index        string `json:"index"`
+	Recipient    string `json:"recipient"`
+}
+
+// RecipientAuthorizationSignBytes returns the bytes signed by the beneficiary of a merkledrop
+// leaf to let the recipient receive its coins
+func RecipientAuthorizationSignBytes(chainID string, mdId, index uint64, recipient sdk.AccAddress) []byte {
+	bz, err := json.Marshal(recipientAuthorization{
+		ChainID:      chainID,
+		MerkledropID: strconv.FormatUint(mdId, 10),
+		Index:        strconv.FormatUint(index, 10),
+		Recipient:    recipient.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// VerifyRecipientAuthorization verifies that the signature over the RecipientAuthorizationSignBytes
+// has been made by the secp256k1 key of the beneficiary
+func VerifyRecipientAuthorization(chainID string, mdId, index uint64, beneficiary, recipient sdk.AccAddress, pubKeyBz, signature []byte) error {
+	if len(pubKeyBz) != secp256k1.PubKeySize {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "invalid public key length %d", len(pubKeyBz))
+	}
+
+	pubKey := &secp256k1.PubKey{Key: pubKeyBz}
+	if !bytes.Equal(pubKey.Address(), beneficiary) {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "public key does not match the beneficiary %s", beneficiary)
+	}
+
+	if !pubKey.VerifySignature(RecipientAuthorizationSignBytes(chainID, mdId, index, recipient), signature) {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "recipient %s not authorized by %s", recipient, beneficiary)
+	}
+
+	return nil
+}